@@ -0,0 +1,63 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// SleepCycleStatus.Conditions Type values.
+const (
+	// ConditionTypeReady is True when the last reconciliation completed
+	// without error.
+	ConditionTypeReady = "Ready"
+
+	// ConditionTypeShutdown is True while the SleepCycle's tagged workloads
+	// are shut down, False while they are awake.
+	ConditionTypeShutdown = "Shutdown"
+
+	// ConditionTypeProgressing is True while a shutdown or wakeup is still
+	// in flight, e.g. mid graceful-drain step.
+	ConditionTypeProgressing = "Progressing"
+
+	// ConditionTypeDegraded is True when the SleepCycle cannot make
+	// progress, e.g. its cron expression fails to parse.
+	ConditionTypeDegraded = "Degraded"
+)
+
+// SleepCycleStatus.Conditions Reason values.
+const (
+	// ConditionReasonOffline is set on ConditionTypeReady/ConditionTypeShutdown
+	// while tagged workloads are shut down.
+	ConditionReasonOffline = "Offline"
+
+	// ConditionReasonOnline is set on ConditionTypeReady/ConditionTypeShutdown
+	// while tagged workloads are awake.
+	ConditionReasonOnline = "Online"
+
+	// ConditionReasonDraining is set on ConditionTypeProgressing while a
+	// graceful shutdown drain is still stepping down.
+	ConditionReasonDraining = "Draining"
+
+	// ConditionReasonCronParseError is set on ConditionTypeDegraded when
+	// Spec.Shutdown or Spec.WakeUp fails to parse.
+	ConditionReasonCronParseError = "CronParseError"
+
+	// ConditionReasonWorkloadScaleFailed is set on ConditionTypeDegraded
+	// when a Runner fails to shut down or wake up a tagged workload.
+	ConditionReasonWorkloadScaleFailed = "WorkloadScaleFailed"
+
+	// ConditionReasonInvalidTimeZone is set on ConditionTypeDegraded when
+	// Spec.TimeZone is not a name time.LoadLocation recognizes.
+	ConditionReasonInvalidTimeZone = "InvalidTimeZone"
+)
@@ -0,0 +1,168 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MemberCluster points at the kubeconfig Secret for one cluster that a
+// FederatedSleepCycle can propagate to.
+type MemberCluster struct {
+	// Name identifies the member cluster in FederatedSleepCycleStatus.Clusters.
+	Name string `json:"name"`
+
+	// SecretRef is the name of a Secret, in the same namespace as the
+	// ClusterSet, holding a kubeconfig under the "kubeconfig" key.
+	SecretRef string `json:"secretRef"`
+
+	// Labels are matched against a FederatedSleepCycle's ClusterSelector, in
+	// addition to the synthetic "name" label always derived from Name.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// ClusterSetSpec is the list of member clusters a FederatedSleepCycle can
+// target, keyed by the labels on each MemberCluster entry.
+type ClusterSetSpec struct {
+	// Members lists the clusters available for federation.
+	Members []MemberCluster `json:"members"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClusterSet is the Schema for the clustersets API
+type ClusterSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterSetSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClusterSetList contains a list of ClusterSet
+type ClusterSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterSet `json:"items"`
+}
+
+// ClusterOverride lets a member cluster diverge from the hub SleepCycleSpec
+// template, e.g. to run on local time or be disabled entirely.
+type ClusterOverride struct {
+	// Cluster is the MemberCluster.Name this override applies to.
+	Cluster string `json:"cluster"`
+
+	// Enabled overrides SleepCycleSpec.Enabled for this cluster.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// TimeZone overrides SleepCycleSpec.TimeZone for this cluster.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Shutdown overrides SleepCycleSpec.Shutdown for this cluster.
+	// +optional
+	Shutdown string `json:"shutdown,omitempty"`
+
+	// WakeUp overrides SleepCycleSpec.WakeUp for this cluster.
+	// +optional
+	WakeUp string `json:"wakeUp,omitempty"`
+}
+
+// FederatedSleepCycleSpec defines the desired state of FederatedSleepCycle
+type FederatedSleepCycleSpec struct {
+	// ClusterSetRef names the ClusterSet (in the hub cluster) whose members
+	// are candidates for this SleepCycle.
+	ClusterSetRef string `json:"clusterSetRef"`
+
+	// ClusterSelector picks which members of the referenced ClusterSet this
+	// SleepCycle is pushed to, matched against each MemberCluster's Labels
+	// plus a synthetic "name" label set to MemberCluster.Name.
+	// +optional
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+
+	// Template is the SleepCycleSpec pushed to each selected member cluster.
+	Template SleepCycleSpec `json:"template"`
+
+	// Overrides lets individual member clusters diverge from Template.
+	// +optional
+	Overrides []ClusterOverride `json:"overrides,omitempty"`
+}
+
+// ClusterStatus is the last observed state of a FederatedSleepCycle on one
+// member cluster.
+type ClusterStatus struct {
+	// Cluster is the MemberCluster.Name this status is for.
+	Cluster string `json:"cluster"`
+
+	// LastShutdownTime is the last time this member reported a shutdown.
+	// +optional
+	LastShutdownTime *metav1.Time `json:"lastShutdownTime,omitempty"`
+
+	// LastWakeupTime is the last time this member reported a wakeup.
+	// +optional
+	LastWakeupTime *metav1.Time `json:"lastWakeupTime,omitempty"`
+
+	// Success reports whether the last propagation to this member succeeded.
+	Success bool `json:"success"`
+
+	// Reason carries a short machine-readable explanation when Success is
+	// false, e.g. "SecretNotFound" or "ApplyFailed".
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// WorkloadsManaged is the number of workloads this member's SleepCycle
+	// last reported in Status.UsedBy.
+	// +optional
+	WorkloadsManaged int `json:"workloadsManaged,omitempty"`
+}
+
+// FederatedSleepCycleStatus defines the observed state of FederatedSleepCycle
+type FederatedSleepCycleStatus struct {
+	// Clusters carries the last observed per-cluster status, one entry per
+	// member cluster the SleepCycle was pushed to.
+	// +optional
+	Clusters []ClusterStatus `json:"clusters,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// FederatedSleepCycle is the Schema for the federatedsleepcycles API
+type FederatedSleepCycle struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FederatedSleepCycleSpec   `json:"spec,omitempty"`
+	Status FederatedSleepCycleStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// FederatedSleepCycleList contains a list of FederatedSleepCycle
+type FederatedSleepCycleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FederatedSleepCycle `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FederatedSleepCycle{}, &FederatedSleepCycleList{})
+	SchemeBuilder.Register(&ClusterSet{}, &ClusterSetList{})
+}
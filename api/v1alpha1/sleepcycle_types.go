@@ -0,0 +1,160 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GracefulShutdownSpec controls how workloads are drained before being
+// scaled to zero, instead of having their replicas set to 0 in one step.
+type GracefulShutdownSpec struct {
+	// Enabled opts a SleepCycle into PodDisruptionBudget-aware draining.
+	// When false (the default), workloads are scaled to 0 immediately.
+	// +optional
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// StepInterval is how long the controller waits between successive
+	// drain steps, to give pods time to terminate and PDBs time to settle.
+	// +optional
+	// +kubebuilder:default="30s"
+	StepInterval *metav1.Duration `json:"stepInterval,omitempty"`
+
+	// TimeoutSeconds bounds the overall drain. If the workload has not
+	// reached 0 replicas within this window, the controller falls back to
+	// an immediate scale down.
+	// +optional
+	// +kubebuilder:default=300
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// SleepCycleSpec defines the desired state of SleepCycle
+type SleepCycleSpec struct {
+	// Enabled turns the SleepCycle on or off. Disabled SleepCycles are
+	// never reconciled and never touch their tagged workloads.
+	// +optional
+	// +kubebuilder:default=true
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Shutdown is the cron expression describing when tagged workloads
+	// should be scaled down / suspended.
+	Shutdown string `json:"shutdown"`
+
+	// WakeUp is the cron expression describing when tagged workloads
+	// should be scaled back up / resumed. Optional: a SleepCycle with no
+	// WakeUp only ever shuts workloads down.
+	// +optional
+	WakeUp string `json:"wakeUp,omitempty"`
+
+	// TimeZone is the IANA Time Zone Database name (e.g. "Europe/Athens")
+	// Shutdown and WakeUp are evaluated in. Defaults to UTC, which matches
+	// the controller's pre-existing pod-local behaviour only if the pod
+	// itself runs in UTC.
+	//
+	// The CEL rule below only checks the shape of the name: CEL has no
+	// access to tzdata, so it can't tell "Europe/Athens" from a
+	// syntactically-valid but nonexistent zone like "Europe/Atlantis". The
+	// validating webhook (see SetupWebhookWithManager) is what rejects that
+	// case at admission, by attempting time.LoadLocation.
+	// +optional
+	// +kubebuilder:default="UTC"
+	// +kubebuilder:validation:XValidation:rule="self.matches('^[A-Za-z0-9_+-]+(/[A-Za-z0-9_+-]+)*$')",message="timeZone must be an IANA Time Zone Database name, e.g. 'Europe/Athens'"
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// GracefulShutdown opts in to PodDisruptionBudget-aware draining
+	// instead of an abrupt Replicas=0 update.
+	// +optional
+	GracefulShutdown *GracefulShutdownSpec `json:"gracefulShutdown,omitempty"`
+
+	// Paused halts scheduled shutdowns and wakeups without disabling the
+	// SleepCycle: unlike Enabled=false, a paused SleepCycle keeps its
+	// recorded Status.UsedBy replica counts and resumes its schedule from
+	// where it left off once unpaused.
+	// +optional
+	// +kubebuilder:default=false
+	Paused bool `json:"paused,omitempty"`
+}
+
+// SleepCycleStatus defines the observed state of SleepCycle
+type SleepCycleStatus struct {
+	// Enabled mirrors Spec.Enabled at the time of the last reconciliation.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// UsedBy records, per workload keyed "<Kind>/<namespace>/<name>", the replica count the
+	// workload had before it was last scaled down, so WakeUp can restore it.
+	// +optional
+	UsedBy map[string]int `json:"usedBy,omitempty"`
+
+	// LastReconciliationLoop is the timestamp of the last reconciliation.
+	// +optional
+	LastReconciliationLoop *metav1.Time `json:"lastReconciliationLoop,omitempty"`
+
+	// Conditions holds the latest observations of the SleepCycle's state,
+	// keyed by Type. See the ConditionType* and ConditionReason* constants.
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// NextScheduledShutdownTime is the next time tagged workloads will be
+	// shut down.
+	// +optional
+	NextScheduledShutdownTime *metav1.Time `json:"nextScheduledShutdownTime,omitempty"`
+
+	// NextScheduledWakeupTime is the next time tagged workloads will be
+	// woken up, if the SleepCycle has a WakeUp schedule.
+	// +optional
+	NextScheduledWakeupTime *metav1.Time `json:"nextScheduledWakeupTime,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=`.spec.shutdown`
+//+kubebuilder:printcolumn:name="WakeUp",type=string,JSONPath=`.spec.wakeUp`
+//+kubebuilder:printcolumn:name="TimeZone",type=string,JSONPath=`.spec.timeZone`,priority=1
+//+kubebuilder:printcolumn:name="Paused",type=boolean,JSONPath=`.spec.paused`
+//+kubebuilder:printcolumn:name="Last Run",type=date,JSONPath=`.status.lastReconciliationLoop`
+//+kubebuilder:printcolumn:name="Last Success",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Next Shutdown",type=date,JSONPath=`.status.nextScheduledShutdownTime`
+//+kubebuilder:printcolumn:name="Next Wakeup",type=date,JSONPath=`.status.nextScheduledWakeupTime`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// SleepCycle is the Schema for the sleepcycles API
+type SleepCycle struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SleepCycleSpec   `json:"spec,omitempty"`
+	Status SleepCycleStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// SleepCycleList contains a list of SleepCycle
+type SleepCycleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SleepCycle `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SleepCycle{}, &SleepCycleList{})
+}
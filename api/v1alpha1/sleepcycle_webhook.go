@@ -0,0 +1,70 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupWebhookWithManager registers SleepCycle's validating webhook with mgr.
+func (r *SleepCycle) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-core-rekuberate-io-v1alpha1-sleepcycle,mutating=false,failurePolicy=fail,sideEffects=None,groups=core.rekuberate.io,resources=sleepcycles,verbs=create;update,versions=v1alpha1,name=vsleepcycle.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &SleepCycle{}
+
+// ValidateCreate implements webhook.Validator. The CEL rule on Spec.TimeZone
+// only checks that it looks like an IANA zone name - it has no access to
+// tzdata, so it can't catch a syntactically-valid but nonexistent zone like
+// "Europe/Atlantis". This webhook is what actually rejects that at
+// admission, the same way time.LoadLocation does at reconcile time.
+func (r *SleepCycle) ValidateCreate() (admission.Warnings, error) {
+	return nil, r.validateTimeZone()
+}
+
+// ValidateUpdate implements webhook.Validator.
+func (r *SleepCycle) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	return nil, r.validateTimeZone()
+}
+
+// ValidateDelete implements webhook.Validator. Nothing to validate on delete.
+func (r *SleepCycle) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (r *SleepCycle) validateTimeZone() error {
+	tz := r.Spec.TimeZone
+	if tz == "" {
+		return nil
+	}
+
+	if _, err := time.LoadLocation(tz); err != nil {
+		return fmt.Errorf("spec.timeZone: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1alpha1 "github.com/rekuberate-io/sleepcycles/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// FederatedSleepCycleReconciler reconciles a FederatedSleepCycle object
+type FederatedSleepCycleReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	logger logr.Logger
+
+	// memberClientFactory builds a client.Client for a member cluster from
+	// its kubeconfig bytes. Overridable in tests.
+	memberClientFactory func(scheme *runtime.Scheme, kubeconfig []byte) (client.Client, error)
+}
+
+//+kubebuilder:rbac:groups=core.rekuberate.io,resources=federatedsleepcycles,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=core.rekuberate.io,resources=federatedsleepcycles/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=core.rekuberate.io,resources=clustersets,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// Reconcile pushes a FederatedSleepCycle's Template, with per-cluster
+// Overrides applied, to every member cluster selected out of its
+// ClusterSetRef, and aggregates each member's outcome back into
+// Status.Clusters.
+func (r *FederatedSleepCycleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	r.logger = log.Log.WithValues("namespace", req.Namespace, "federatedsleepcycle", req.Name)
+
+	var federatedSleepCycle corev1alpha1.FederatedSleepCycle
+	if err := r.Get(ctx, req.NamespacedName, &federatedSleepCycle); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		r.logger.Error(err, "🛑 unable to fetch FederatedSleepCycle")
+		return ctrl.Result{}, err
+	}
+
+	var clusterSet corev1alpha1.ClusterSet
+	clusterSetKey := client.ObjectKey{Namespace: req.Namespace, Name: federatedSleepCycle.Spec.ClusterSetRef}
+	if err := r.Get(ctx, clusterSetKey, &clusterSet); err != nil {
+		r.logger.Error(err, "🛑 unable to fetch ClusterSet", "clusterSet", federatedSleepCycle.Spec.ClusterSetRef)
+		return ctrl.Result{}, err
+	}
+
+	deepCopy := *federatedSleepCycle.DeepCopy()
+	deepCopy.Status.Clusters = make([]corev1alpha1.ClusterStatus, 0, len(clusterSet.Spec.Members))
+
+	for _, member := range clusterSet.Spec.Members {
+		if !r.MemberSelected(federatedSleepCycle, member) {
+			continue
+		}
+
+		deepCopy.Status.Clusters = append(deepCopy.Status.Clusters, r.ReconcileMember(ctx, req.Namespace, federatedSleepCycle, member))
+	}
+
+	if err := r.Status().Update(ctx, &deepCopy); err != nil {
+		r.logger.Error(err, "🛑️ failed to update FederatedSleepCycle Status")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+}
+
+// MemberSelected reports whether member matches federatedSleepCycle's
+// ClusterSelector. A nil selector matches every member.
+func (r *FederatedSleepCycleReconciler) MemberSelected(federatedSleepCycle corev1alpha1.FederatedSleepCycle, member corev1alpha1.MemberCluster) bool {
+	if federatedSleepCycle.Spec.ClusterSelector == nil {
+		return true
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(federatedSleepCycle.Spec.ClusterSelector)
+	if err != nil {
+		return false
+	}
+
+	return selector.Matches(clusterLabelsFor(member))
+}
+
+// ReconcileMember pushes the effective SleepCycle for one member cluster and
+// reports back its outcome.
+func (r *FederatedSleepCycleReconciler) ReconcileMember(ctx context.Context, namespace string, federatedSleepCycle corev1alpha1.FederatedSleepCycle, member corev1alpha1.MemberCluster) corev1alpha1.ClusterStatus {
+	status := corev1alpha1.ClusterStatus{Cluster: member.Name}
+
+	memberClient, err := r.MemberClient(ctx, namespace, member)
+	if err != nil {
+		status.Success = false
+		status.Reason = "SecretNotFound"
+		r.logger.Error(err, "🛑 unable to build client for member cluster", "cluster", member.Name)
+		return status
+	}
+
+	sleepCycle := corev1alpha1.SleepCycle{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1alpha1.GroupVersion.String(),
+			Kind:       "SleepCycle",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      federatedSleepCycle.Name,
+			Namespace: federatedSleepCycle.Namespace,
+		},
+		Spec: r.EffectiveSpec(federatedSleepCycle, member.Name),
+	}
+
+	if err := memberClient.Patch(ctx, &sleepCycle, client.Apply, client.ForceOwnership, client.FieldOwner("federatedsleepcycle-controller")); err != nil {
+		status.Success = false
+		status.Reason = "ApplyFailed"
+		r.logger.Error(err, "🛑 unable to apply SleepCycle on member cluster", "cluster", member.Name)
+		return status
+	}
+
+	status.Success = true
+	status.WorkloadsManaged = len(sleepCycle.Status.UsedBy)
+
+	if shutdownCondition := meta.FindStatusCondition(sleepCycle.Status.Conditions, corev1alpha1.ConditionTypeShutdown); shutdownCondition != nil {
+		observedAt := shutdownCondition.LastTransitionTime
+		if shutdownCondition.Status == metav1.ConditionTrue {
+			status.LastShutdownTime = &observedAt
+		} else {
+			status.LastWakeupTime = &observedAt
+		}
+	}
+
+	return status
+}
+
+// EffectiveSpec applies the ClusterOverride matching clusterName, if any, on
+// top of federatedSleepCycle.Spec.Template.
+func (r *FederatedSleepCycleReconciler) EffectiveSpec(federatedSleepCycle corev1alpha1.FederatedSleepCycle, clusterName string) corev1alpha1.SleepCycleSpec {
+	spec := *federatedSleepCycle.Spec.Template.DeepCopy()
+
+	for _, override := range federatedSleepCycle.Spec.Overrides {
+		if override.Cluster != clusterName {
+			continue
+		}
+
+		if override.Enabled != nil {
+			spec.Enabled = *override.Enabled
+		}
+		if override.TimeZone != "" {
+			spec.TimeZone = override.TimeZone
+		}
+		if override.Shutdown != "" {
+			spec.Shutdown = override.Shutdown
+		}
+		if override.WakeUp != "" {
+			spec.WakeUp = override.WakeUp
+		}
+	}
+
+	return spec
+}
+
+// MemberClient returns a client.Client for member, built from the kubeconfig
+// stored in the Secret named by member.SecretRef.
+func (r *FederatedSleepCycleReconciler) MemberClient(ctx context.Context, namespace string, member corev1alpha1.MemberCluster) (client.Client, error) {
+	var secret corev1.Secret
+	if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: member.SecretRef}, &secret); err != nil {
+		return nil, err
+	}
+
+	kubeconfig, ok := secret.Data["kubeconfig"]
+	if !ok {
+		return nil, fmt.Errorf("secret %v/%v has no \"kubeconfig\" key", namespace, member.SecretRef)
+	}
+
+	factory := r.memberClientFactory
+	if factory == nil {
+		factory = newMemberClient
+	}
+
+	return factory(r.Scheme, kubeconfig)
+}
+
+func newMemberClient(scheme *runtime.Scheme, kubeconfig []byte) (client.Client, error) {
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.New(restConfig, client.Options{Scheme: scheme})
+}
+
+// clusterLabels adapts a MemberCluster's Labels, plus its synthetic "name"
+// pseudo-label, into the labels.Labels interface so ClusterSelector can be
+// evaluated against it.
+type clusterLabels struct {
+	name   string
+	labels map[string]string
+}
+
+// clusterLabelsFor builds the label set a ClusterSelector is matched
+// against for member: its own Labels, plus a synthetic "name" label so
+// selectors can still target a member by Name alone.
+func clusterLabelsFor(member corev1alpha1.MemberCluster) clusterLabels {
+	return clusterLabels{name: member.Name, labels: member.Labels}
+}
+
+func (c clusterLabels) Has(label string) bool {
+	if label == "name" {
+		return true
+	}
+	_, ok := c.labels[label]
+	return ok
+}
+
+func (c clusterLabels) Get(label string) string {
+	if label == "name" {
+		return c.name
+	}
+	return c.labels[label]
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *FederatedSleepCycleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1alpha1.FederatedSleepCycle{}).
+		Complete(r)
+}
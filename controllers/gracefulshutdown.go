@@ -0,0 +1,251 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	corev1alpha1 "github.com/rekuberate-io/sleepcycles/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultGracefulShutdownStepInterval is used when GracefulShutdownSpec
+	// does not specify a StepInterval.
+	DefaultGracefulShutdownStepInterval = 30 * time.Second
+
+	// DefaultGracefulShutdownTimeoutSeconds is used when GracefulShutdownSpec
+	// does not specify a TimeoutSeconds.
+	DefaultGracefulShutdownTimeoutSeconds = 300
+
+	// drainStartedAtAnnotation records, in RFC3339, when a workload's
+	// graceful drain began, so NextDrainStep's caller can tell once
+	// GracefulShutdownSpec.TimeoutSeconds has elapsed and fall back to an
+	// immediate scale down.
+	drainStartedAtAnnotation = "rekuberate.io/drain-started-at"
+)
+
+// DrainDeployment steps a Deployment's replicas down towards 0 instead of
+// scaling it to 0 in one move, honoring any matching PodDisruptionBudget.
+// It returns a non-zero RequeueAfter when further steps are still needed.
+func (r *SleepCycleReconciler) DrainDeployment(ctx context.Context, deployment appsv1.Deployment, gracefulShutdown corev1alpha1.GracefulShutdownSpec) (ctrl.Result, error) {
+	current := *deployment.Spec.Replicas
+	if current == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	if elapsed, started := drainElapsed(&deployment); started && elapsed >= drainTimeout(gracefulShutdown) {
+		if err := r.ScaleDeployment(ctx, deployment, 0); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, r.clearDrainStartedAt(ctx, &deployment)
+	}
+
+	if err := r.markDrainStarted(ctx, &deployment); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	next, err := r.NextDrainStep(ctx, deployment.Namespace, deployment.Spec.Selector, current)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := r.ScaleDeployment(ctx, deployment, next); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if next > 0 {
+		return ctrl.Result{RequeueAfter: stepInterval(gracefulShutdown)}, nil
+	}
+
+	return ctrl.Result{}, r.clearDrainStartedAt(ctx, &deployment)
+}
+
+// DrainStatefulSet is the StatefulSet equivalent of DrainDeployment.
+func (r *SleepCycleReconciler) DrainStatefulSet(ctx context.Context, statefulSet appsv1.StatefulSet, gracefulShutdown corev1alpha1.GracefulShutdownSpec) (ctrl.Result, error) {
+	current := *statefulSet.Spec.Replicas
+	if current == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	if elapsed, started := drainElapsed(&statefulSet); started && elapsed >= drainTimeout(gracefulShutdown) {
+		if err := r.ScaleStatefulSet(ctx, statefulSet, 0); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, r.clearDrainStartedAt(ctx, &statefulSet)
+	}
+
+	if err := r.markDrainStarted(ctx, &statefulSet); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	next, err := r.NextDrainStep(ctx, statefulSet.Namespace, statefulSet.Spec.Selector, current)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := r.ScaleStatefulSet(ctx, statefulSet, next); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if next > 0 {
+		return ctrl.Result{RequeueAfter: stepInterval(gracefulShutdown)}, nil
+	}
+
+	return ctrl.Result{}, r.clearDrainStartedAt(ctx, &statefulSet)
+}
+
+// NextDrainStep computes the next replica count on the way to 0 for a
+// workload currently running current replicas. When no PodDisruptionBudget
+// matches the workload's pods, it halves the replica count each step. When a
+// PDB matches, it steps down by as many replicas as the PDB allows to be
+// unavailable at once, but always by at least one, so a PDB that allows no
+// disruption still gets drained one replica per step.
+func (r *SleepCycleReconciler) NextDrainStep(ctx context.Context, namespace string, selector *metav1.LabelSelector, current int32) (int32, error) {
+	pdb, err := r.GetMatchingPodDisruptionBudget(ctx, namespace, selector)
+	if err != nil {
+		return 0, err
+	}
+
+	if pdb == nil {
+		half := current / 2
+		if half == current {
+			half = 0
+		}
+		return half, nil
+	}
+
+	maxUnavailable := int32(1)
+	switch {
+	case pdb.Spec.MaxUnavailable != nil:
+		// Round down: MaxUnavailable is very commonly a percentage (e.g.
+		// "50%"), and IntValue() alone can't resolve that against current -
+		// it just fails to parse the "%" and silently returns 0, which
+		// turned an intended percentage step into "drain everything at once".
+		value, err := intstr.GetScaledValueFromIntOrPercent(pdb.Spec.MaxUnavailable, int(current), false)
+		if err != nil {
+			return 0, err
+		}
+		maxUnavailable = int32(value)
+	case pdb.Spec.MinAvailable != nil:
+		// Round up so current-minAvailable stays conservative (a smaller
+		// step) rather than overestimating how many replicas can go away.
+		value, err := intstr.GetScaledValueFromIntOrPercent(pdb.Spec.MinAvailable, int(current), true)
+		if err != nil {
+			return 0, err
+		}
+		maxUnavailable = current - int32(value)
+	}
+
+	if maxUnavailable < 1 {
+		maxUnavailable = 1
+	}
+
+	next := current - maxUnavailable
+	if next < 0 {
+		next = 0
+	}
+	if next >= current {
+		next = current - 1
+	}
+
+	return next, nil
+}
+
+// GetMatchingPodDisruptionBudget returns the PodDisruptionBudget in namespace
+// whose selector matches the workload's pod selector, if any.
+func (r *SleepCycleReconciler) GetMatchingPodDisruptionBudget(ctx context.Context, namespace string, selector *metav1.LabelSelector) (*policyv1.PodDisruptionBudget, error) {
+	if selector == nil {
+		return nil, nil
+	}
+
+	pdbList := policyv1.PodDisruptionBudgetList{}
+	if err := r.List(ctx, &pdbList, &client.ListOptions{Namespace: namespace}); err != nil {
+		return nil, err
+	}
+
+	podLabels := labels.Set(selector.MatchLabels)
+
+	for i := range pdbList.Items {
+		pdb := pdbList.Items[i]
+
+		pdbSelector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			continue
+		}
+
+		if pdbSelector.Matches(podLabels) {
+			return &pdb, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func stepInterval(gracefulShutdown corev1alpha1.GracefulShutdownSpec) time.Duration {
+	if gracefulShutdown.StepInterval == nil {
+		return DefaultGracefulShutdownStepInterval
+	}
+
+	return gracefulShutdown.StepInterval.Duration
+}
+
+func drainTimeout(gracefulShutdown corev1alpha1.GracefulShutdownSpec) time.Duration {
+	if gracefulShutdown.TimeoutSeconds == nil {
+		return DefaultGracefulShutdownTimeoutSeconds * time.Second
+	}
+
+	return time.Duration(*gracefulShutdown.TimeoutSeconds) * time.Second
+}
+
+// drainElapsed reports how long ago obj's graceful drain started, and false
+// if it hasn't been marked as draining (or the stamp is unparsable).
+func drainElapsed(obj client.Object) (time.Duration, bool) {
+	startedAt, ok := obj.GetAnnotations()[drainStartedAtAnnotation]
+	if !ok {
+		return 0, false
+	}
+
+	t, err := time.Parse(time.RFC3339, startedAt)
+	if err != nil {
+		return 0, false
+	}
+
+	return time.Since(t), true
+}
+
+// markDrainStarted stamps obj with drainStartedAtAnnotation the first time
+// it is seen draining. A no-op on every subsequent step.
+func (r *SleepCycleReconciler) markDrainStarted(ctx context.Context, obj client.Object) error {
+	annotations := obj.GetAnnotations()
+	if _, ok := annotations[drainStartedAtAnnotation]; ok {
+		return nil
+	}
+
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[drainStartedAtAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	obj.SetAnnotations(annotations)
+
+	return r.Update(ctx, obj)
+}
+
+// clearDrainStartedAt removes drainStartedAtAnnotation once a drain has
+// finished, whether by reaching 0 replicas or by timing out.
+func (r *SleepCycleReconciler) clearDrainStartedAt(ctx context.Context, obj client.Object) error {
+	annotations := obj.GetAnnotations()
+	if _, ok := annotations[drainStartedAtAnnotation]; !ok {
+		return nil
+	}
+
+	delete(annotations, drainStartedAtAnnotation)
+	obj.SetAnnotations(annotations)
+
+	return r.Update(ctx, obj)
+}
@@ -0,0 +1,52 @@
+package controllers
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	shutdownTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "sleepcycle_shutdown_total",
+		Help: "Total number of workloads shut down by a SleepCycle.",
+	}, []string{"namespace", "name", "kind"})
+
+	wakeupTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "sleepcycle_wakeup_total",
+		Help: "Total number of workloads woken up by a SleepCycle.",
+	}, []string{"namespace", "name", "kind"})
+
+	reconcileDurationSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "sleepcycle_reconcile_duration_seconds",
+		Help: "Duration of SleepCycle reconcile loops.",
+	}, []string{"namespace", "name"})
+
+	nextShutdownTimestampSeconds = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "sleepcycle_next_shutdown_timestamp_seconds",
+		Help: "Unix timestamp of a SleepCycle's next scheduled shutdown.",
+	}, []string{"namespace", "name"})
+
+	workloadsManaged = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "sleepcycle_workloads_managed",
+		Help: "Number of workloads currently tagged with a SleepCycle, by kind.",
+	}, []string{"namespace", "name", "kind"})
+)
+
+// registerMetrics registers every SleepCycle metrics collector with reg. It
+// is safe to call more than once with the same Registerer - AlreadyRegistered
+// errors are ignored, since SetupWithManager may run against a Registerer
+// that already has these collectors from a previous registration.
+func registerMetrics(reg prometheus.Registerer) {
+	for _, collector := range []prometheus.Collector{
+		shutdownTotal,
+		wakeupTotal,
+		reconcileDurationSeconds,
+		nextShutdownTimestampSeconds,
+		workloadsManaged,
+	} {
+		if err := reg.Register(collector); err != nil {
+			if _, ok := err.(prometheus.AlreadyRegisteredError); !ok {
+				panic(err)
+			}
+		}
+	}
+}
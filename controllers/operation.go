@@ -0,0 +1,27 @@
+package controllers
+
+// SleepCycleOperation represents the operation the controller should take
+// against the workloads tagged with a given SleepCycle during the current
+// reconciliation.
+type SleepCycleOperation int
+
+const (
+	// Watch means neither a shutdown nor a wakeup is currently due; tagged
+	// workloads are left untouched.
+	Watch SleepCycleOperation = iota
+	// Shutdown means tagged workloads should be scaled down / suspended.
+	Shutdown
+	// WakeUp means tagged workloads should be scaled back up / resumed.
+	WakeUp
+)
+
+func (o SleepCycleOperation) String() string {
+	switch o {
+	case Shutdown:
+		return "shutdown"
+	case WakeUp:
+		return "wake-up"
+	default:
+		return "watch"
+	}
+}
@@ -0,0 +1,81 @@
+package controllers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	corev1alpha1 "github.com/rekuberate-io/sleepcycles/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// State is a workload's replica count (or equivalent "how many were running
+// before shutdown") as captured by a Runner's SnapshotState, so WakeUp can
+// restore it.
+type State int32
+
+// Runner knows how to list, shut down and wake up one kind of workload. The
+// built-in kinds (Deployment, StatefulSet, CronJob, HorizontalPodAutoscaler,
+// Argo Rollout, KEDA ScaledObject, KubeVirt VirtualMachine and Knative
+// Service) ship as Runners registered by this package's init(); callers can add
+// support for further kinds by implementing Runner and calling
+// RegisterRunner, without touching SleepCycleReconciler itself.
+type Runner interface {
+	// Kind identifies the workload kind this Runner manages.
+	Kind() schema.GroupVersionKind
+
+	// List returns every object of this kind in namespace.
+	List(ctx context.Context, c client.Client, namespace string) ([]client.Object, error)
+
+	// SnapshotState captures the replica count obj should be restored to on
+	// WakeUp, e.g. its current replicas while it is still running.
+	SnapshotState(obj client.Object) State
+
+	// Shutdown scales/suspends obj down. A non-zero requeueAfter means obj
+	// is not fully shut down yet (e.g. mid PDB-aware drain) and Shutdown
+	// should be called again after that duration.
+	Shutdown(ctx context.Context, c client.Client, obj client.Object, sleepCycle *corev1alpha1.SleepCycle) (requeueAfter time.Duration, err error)
+
+	// WakeUp restores obj using previousState, as captured by an earlier
+	// SnapshotState call.
+	WakeUp(ctx context.Context, c client.Client, obj client.Object, sleepCycle *corev1alpha1.SleepCycle, previousState State) error
+}
+
+var (
+	runnerRegistryMu sync.RWMutex
+	runnerRegistry   = map[string]Runner{}
+)
+
+// RegisterRunner adds (or replaces) the Runner handling its Kind(). It is
+// safe to call from an init() func, including from outside this package.
+func RegisterRunner(r Runner) {
+	runnerRegistryMu.Lock()
+	defer runnerRegistryMu.Unlock()
+
+	runnerRegistry[r.Kind().String()] = r
+}
+
+// Runners returns every currently registered Runner.
+func Runners() []Runner {
+	runnerRegistryMu.RLock()
+	defer runnerRegistryMu.RUnlock()
+
+	out := make([]Runner, 0, len(runnerRegistry))
+	for _, r := range runnerRegistry {
+		out = append(out, r)
+	}
+
+	return out
+}
+
+func init() {
+	RegisterRunner(&DeploymentRunner{})
+	RegisterRunner(&StatefulSetRunner{})
+	RegisterRunner(&CronJobRunner{})
+	RegisterRunner(&HorizontalPodAutoscalerRunner{})
+	RegisterRunner(NewArgoRolloutRunner())
+	RegisterRunner(NewKedaScaledObjectRunner())
+	RegisterRunner(&KubeVirtVirtualMachineRunner{})
+	RegisterRunner(&KnativeServiceRunner{})
+}
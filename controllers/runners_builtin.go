@@ -0,0 +1,211 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	corev1alpha1 "github.com/rekuberate-io/sleepcycles/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DeploymentRunner is the built-in Runner for apps/v1 Deployments.
+type DeploymentRunner struct{}
+
+func (DeploymentRunner) Kind() schema.GroupVersionKind {
+	return appsv1.SchemeGroupVersion.WithKind("Deployment")
+}
+
+func (DeploymentRunner) List(ctx context.Context, c client.Client, namespace string) ([]client.Object, error) {
+	var list appsv1.DeploymentList
+	if err := c.List(ctx, &list, &client.ListOptions{Namespace: namespace}); err != nil {
+		return nil, err
+	}
+
+	objs := make([]client.Object, len(list.Items))
+	for i := range list.Items {
+		objs[i] = &list.Items[i]
+	}
+	return objs, nil
+}
+
+func (DeploymentRunner) SnapshotState(obj client.Object) State {
+	return State(obj.(*appsv1.Deployment).Status.Replicas)
+}
+
+func (DeploymentRunner) Shutdown(ctx context.Context, c client.Client, obj client.Object, sleepCycle *corev1alpha1.SleepCycle) (time.Duration, error) {
+	deployment := *obj.(*appsv1.Deployment)
+
+	if deployment.Status.Replicas == 0 {
+		return 0, nil
+	}
+
+	reconciler := &SleepCycleReconciler{Client: c}
+
+	if sleepCycle.Spec.GracefulShutdown != nil && sleepCycle.Spec.GracefulShutdown.Enabled {
+		result, err := reconciler.DrainDeployment(ctx, deployment, *sleepCycle.Spec.GracefulShutdown)
+		return result.RequeueAfter, err
+	}
+
+	return 0, reconciler.ScaleDeployment(ctx, deployment, 0)
+}
+
+func (DeploymentRunner) WakeUp(ctx context.Context, c client.Client, obj client.Object, sleepCycle *corev1alpha1.SleepCycle, previousState State) error {
+	deployment := *obj.(*appsv1.Deployment)
+
+	if deployment.Status.Replicas == int32(previousState) {
+		return nil
+	}
+
+	reconciler := &SleepCycleReconciler{Client: c}
+	return reconciler.ScaleDeployment(ctx, deployment, int32(previousState))
+}
+
+// StatefulSetRunner is the built-in Runner for apps/v1 StatefulSets.
+type StatefulSetRunner struct{}
+
+func (StatefulSetRunner) Kind() schema.GroupVersionKind {
+	return appsv1.SchemeGroupVersion.WithKind("StatefulSet")
+}
+
+func (StatefulSetRunner) List(ctx context.Context, c client.Client, namespace string) ([]client.Object, error) {
+	var list appsv1.StatefulSetList
+	if err := c.List(ctx, &list, &client.ListOptions{Namespace: namespace}); err != nil {
+		return nil, err
+	}
+
+	objs := make([]client.Object, len(list.Items))
+	for i := range list.Items {
+		objs[i] = &list.Items[i]
+	}
+	return objs, nil
+}
+
+func (StatefulSetRunner) SnapshotState(obj client.Object) State {
+	return State(obj.(*appsv1.StatefulSet).Status.Replicas)
+}
+
+func (StatefulSetRunner) Shutdown(ctx context.Context, c client.Client, obj client.Object, sleepCycle *corev1alpha1.SleepCycle) (time.Duration, error) {
+	statefulSet := *obj.(*appsv1.StatefulSet)
+
+	if statefulSet.Status.Replicas == 0 {
+		return 0, nil
+	}
+
+	reconciler := &SleepCycleReconciler{Client: c}
+
+	if sleepCycle.Spec.GracefulShutdown != nil && sleepCycle.Spec.GracefulShutdown.Enabled {
+		result, err := reconciler.DrainStatefulSet(ctx, statefulSet, *sleepCycle.Spec.GracefulShutdown)
+		return result.RequeueAfter, err
+	}
+
+	return 0, reconciler.ScaleStatefulSet(ctx, statefulSet, 0)
+}
+
+func (StatefulSetRunner) WakeUp(ctx context.Context, c client.Client, obj client.Object, sleepCycle *corev1alpha1.SleepCycle, previousState State) error {
+	statefulSet := *obj.(*appsv1.StatefulSet)
+
+	if statefulSet.Status.Replicas == int32(previousState) {
+		return nil
+	}
+
+	reconciler := &SleepCycleReconciler{Client: c}
+	return reconciler.ScaleStatefulSet(ctx, statefulSet, int32(previousState))
+}
+
+// CronJobRunner is the built-in Runner for batch/v1 CronJobs. CronJobs have
+// no replica count to restore, so SnapshotState always reports 0.
+type CronJobRunner struct{}
+
+func (CronJobRunner) Kind() schema.GroupVersionKind {
+	return batchv1.SchemeGroupVersion.WithKind("CronJob")
+}
+
+func (CronJobRunner) List(ctx context.Context, c client.Client, namespace string) ([]client.Object, error) {
+	var list batchv1.CronJobList
+	if err := c.List(ctx, &list, &client.ListOptions{Namespace: namespace}); err != nil {
+		return nil, err
+	}
+
+	objs := make([]client.Object, len(list.Items))
+	for i := range list.Items {
+		objs[i] = &list.Items[i]
+	}
+	return objs, nil
+}
+
+func (CronJobRunner) SnapshotState(client.Object) State {
+	return 0
+}
+
+func (CronJobRunner) Shutdown(ctx context.Context, c client.Client, obj client.Object, sleepCycle *corev1alpha1.SleepCycle) (time.Duration, error) {
+	cronJob := *obj.(*batchv1.CronJob)
+
+	if *cronJob.Spec.Suspend {
+		return 0, nil
+	}
+
+	reconciler := &SleepCycleReconciler{Client: c}
+	return 0, reconciler.SuspendCronJob(ctx, cronJob, true)
+}
+
+func (CronJobRunner) WakeUp(ctx context.Context, c client.Client, obj client.Object, sleepCycle *corev1alpha1.SleepCycle, previousState State) error {
+	cronJob := *obj.(*batchv1.CronJob)
+
+	if !*cronJob.Spec.Suspend {
+		return nil
+	}
+
+	reconciler := &SleepCycleReconciler{Client: c}
+	return reconciler.SuspendCronJob(ctx, cronJob, false)
+}
+
+// HorizontalPodAutoscalerRunner is the built-in Runner for autoscaling/v1
+// HorizontalPodAutoscalers.
+type HorizontalPodAutoscalerRunner struct{}
+
+func (HorizontalPodAutoscalerRunner) Kind() schema.GroupVersionKind {
+	return autoscalingv1.SchemeGroupVersion.WithKind("HorizontalPodAutoscaler")
+}
+
+func (HorizontalPodAutoscalerRunner) List(ctx context.Context, c client.Client, namespace string) ([]client.Object, error) {
+	var list autoscalingv1.HorizontalPodAutoscalerList
+	if err := c.List(ctx, &list, &client.ListOptions{Namespace: namespace}); err != nil {
+		return nil, err
+	}
+
+	objs := make([]client.Object, len(list.Items))
+	for i := range list.Items {
+		objs[i] = &list.Items[i]
+	}
+	return objs, nil
+}
+
+func (HorizontalPodAutoscalerRunner) SnapshotState(obj client.Object) State {
+	return State(obj.(*autoscalingv1.HorizontalPodAutoscaler).Spec.MaxReplicas)
+}
+
+func (HorizontalPodAutoscalerRunner) Shutdown(ctx context.Context, c client.Client, obj client.Object, sleepCycle *corev1alpha1.SleepCycle) (time.Duration, error) {
+	hpa := *obj.(*autoscalingv1.HorizontalPodAutoscaler)
+
+	if hpa.Spec.MaxReplicas == 1 {
+		return 0, nil
+	}
+
+	reconciler := &SleepCycleReconciler{Client: c}
+	return 0, reconciler.ScaleHorizontalPodAutoscaler(ctx, hpa, 1)
+}
+
+func (HorizontalPodAutoscalerRunner) WakeUp(ctx context.Context, c client.Client, obj client.Object, sleepCycle *corev1alpha1.SleepCycle, previousState State) error {
+	hpa := *obj.(*autoscalingv1.HorizontalPodAutoscaler)
+
+	if hpa.Spec.MaxReplicas == int32(previousState) {
+		return nil
+	}
+
+	reconciler := &SleepCycleReconciler{Client: c}
+	return reconciler.ScaleHorizontalPodAutoscaler(ctx, hpa, int32(previousState))
+}
@@ -0,0 +1,212 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1alpha1 "github.com/rekuberate-io/sleepcycles/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// unstructuredReplicaRunner is shared plumbing for Runners that manage a CRD
+// through an unstructured.Unstructured rather than a typed client, keyed by
+// a single int64 field at replicasPath for both the desired and the
+// previously-seen replica count.
+type unstructuredReplicaRunner struct {
+	gvk          schema.GroupVersionKind
+	replicasPath []string
+	pausedValue  int64
+}
+
+func (r unstructuredReplicaRunner) Kind() schema.GroupVersionKind {
+	return r.gvk
+}
+
+func (r unstructuredReplicaRunner) List(ctx context.Context, c client.Client, namespace string) ([]client.Object, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(r.gvk)
+
+	if err := c.List(ctx, list, &client.ListOptions{Namespace: namespace}); err != nil {
+		return nil, err
+	}
+
+	objs := make([]client.Object, len(list.Items))
+	for i := range list.Items {
+		objs[i] = &list.Items[i]
+	}
+	return objs, nil
+}
+
+func (r unstructuredReplicaRunner) SnapshotState(obj client.Object) State {
+	replicas, found, err := unstructured.NestedInt64(obj.(*unstructured.Unstructured).Object, r.replicasPath...)
+	if err != nil || !found {
+		return 0
+	}
+	return State(replicas)
+}
+
+func (r unstructuredReplicaRunner) Shutdown(ctx context.Context, c client.Client, obj client.Object, sleepCycle *corev1alpha1.SleepCycle) (time.Duration, error) {
+	return 0, r.setReplicas(ctx, c, obj.(*unstructured.Unstructured), r.pausedValue)
+}
+
+func (r unstructuredReplicaRunner) WakeUp(ctx context.Context, c client.Client, obj client.Object, sleepCycle *corev1alpha1.SleepCycle, previousState State) error {
+	return r.setReplicas(ctx, c, obj.(*unstructured.Unstructured), int64(previousState))
+}
+
+func (r unstructuredReplicaRunner) setReplicas(ctx context.Context, c client.Client, obj *unstructured.Unstructured, replicas int64) error {
+	deepCopy := obj.DeepCopy()
+	if err := unstructured.SetNestedField(deepCopy.Object, replicas, r.replicasPath...); err != nil {
+		return err
+	}
+
+	return c.Update(ctx, deepCopy)
+}
+
+// ArgoRolloutRunner manages argoproj.io/v1alpha1 Rollouts the same way
+// DeploymentRunner manages Deployments, by driving spec.replicas.
+type ArgoRolloutRunner struct {
+	unstructuredReplicaRunner
+}
+
+func NewArgoRolloutRunner() *ArgoRolloutRunner {
+	return &ArgoRolloutRunner{unstructuredReplicaRunner{
+		gvk:          schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"},
+		replicasPath: []string{"spec", "replicas"},
+	}}
+}
+
+// KedaScaledObjectRunner manages keda.sh/v1alpha1 ScaledObjects by driving
+// spec.minReplicaCount, the floor KEDA itself will never scale below.
+type KedaScaledObjectRunner struct {
+	unstructuredReplicaRunner
+}
+
+func NewKedaScaledObjectRunner() *KedaScaledObjectRunner {
+	return &KedaScaledObjectRunner{unstructuredReplicaRunner{
+		gvk:          schema.GroupVersionKind{Group: "keda.sh", Version: "v1alpha1", Kind: "ScaledObject"},
+		replicasPath: []string{"spec", "minReplicaCount"},
+	}}
+}
+
+// KubeVirtVirtualMachineRunner manages kubevirt.io/v1 VirtualMachines, which
+// have no replica count: spec.running is the on/off switch instead.
+type KubeVirtVirtualMachineRunner struct{}
+
+func (KubeVirtVirtualMachineRunner) Kind() schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: "kubevirt.io", Version: "v1", Kind: "VirtualMachine"}
+}
+
+func (r KubeVirtVirtualMachineRunner) List(ctx context.Context, c client.Client, namespace string) ([]client.Object, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(r.Kind())
+
+	if err := c.List(ctx, list, &client.ListOptions{Namespace: namespace}); err != nil {
+		return nil, err
+	}
+
+	objs := make([]client.Object, len(list.Items))
+	for i := range list.Items {
+		objs[i] = &list.Items[i]
+	}
+	return objs, nil
+}
+
+func (KubeVirtVirtualMachineRunner) SnapshotState(obj client.Object) State {
+	running, found, err := unstructured.NestedBool(obj.(*unstructured.Unstructured).Object, "spec", "running")
+	if err != nil || !found || !running {
+		return 0
+	}
+	return 1
+}
+
+func (KubeVirtVirtualMachineRunner) Shutdown(ctx context.Context, c client.Client, obj client.Object, sleepCycle *corev1alpha1.SleepCycle) (time.Duration, error) {
+	return 0, setRunning(ctx, c, obj.(*unstructured.Unstructured), false)
+}
+
+func (KubeVirtVirtualMachineRunner) WakeUp(ctx context.Context, c client.Client, obj client.Object, sleepCycle *corev1alpha1.SleepCycle, previousState State) error {
+	return setRunning(ctx, c, obj.(*unstructured.Unstructured), previousState != 0)
+}
+
+func setRunning(ctx context.Context, c client.Client, obj *unstructured.Unstructured, running bool) error {
+	deepCopy := obj.DeepCopy()
+	if err := unstructured.SetNestedField(deepCopy.Object, running, "spec", "running"); err != nil {
+		return err
+	}
+
+	return c.Update(ctx, deepCopy)
+}
+
+// KnativeServiceRunner manages serving.knative.dev/v1 Services. Knative
+// already scales an idle revision to zero on its own once traffic stops, but
+// leaves maxScale unbounded (a maxScale of 0 means "no upper bound", not
+// "capped at zero" - it's a default/unset sentinel, not a ceiling). Pinning
+// both minScale and maxScale to 0 on "shutdown" forces the floor as well as
+// the ceiling to zero, so the revision can't hold (or be scaled back up to)
+// any replicas; "wakeup" removes both pins, returning the Service to its
+// configured autoscaling bounds.
+type KnativeServiceRunner struct{}
+
+const (
+	knativeMinScaleAnnotation = "autoscaling.knative.dev/minScale"
+	knativeMaxScaleAnnotation = "autoscaling.knative.dev/maxScale"
+)
+
+func (KnativeServiceRunner) Kind() schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: "serving.knative.dev", Version: "v1", Kind: "Service"}
+}
+
+func (r KnativeServiceRunner) List(ctx context.Context, c client.Client, namespace string) ([]client.Object, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(r.Kind())
+
+	if err := c.List(ctx, list, &client.ListOptions{Namespace: namespace}); err != nil {
+		return nil, err
+	}
+
+	objs := make([]client.Object, len(list.Items))
+	for i := range list.Items {
+		objs[i] = &list.Items[i]
+	}
+	return objs, nil
+}
+
+func (KnativeServiceRunner) SnapshotState(obj client.Object) State {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		return 0
+	}
+
+	if _, paused := annotations[knativeMinScaleAnnotation]; paused {
+		return 0
+	}
+
+	return 1
+}
+
+func (KnativeServiceRunner) Shutdown(ctx context.Context, c client.Client, obj client.Object, sleepCycle *corev1alpha1.SleepCycle) (time.Duration, error) {
+	deepCopy := obj.(*unstructured.Unstructured).DeepCopy()
+
+	annotations := deepCopy.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[knativeMinScaleAnnotation] = fmt.Sprintf("%d", 0)
+	annotations[knativeMaxScaleAnnotation] = fmt.Sprintf("%d", 0)
+	deepCopy.SetAnnotations(annotations)
+
+	return 0, c.Update(ctx, deepCopy)
+}
+
+func (KnativeServiceRunner) WakeUp(ctx context.Context, c client.Client, obj client.Object, sleepCycle *corev1alpha1.SleepCycle, previousState State) error {
+	deepCopy := obj.(*unstructured.Unstructured).DeepCopy()
+
+	annotations := deepCopy.GetAnnotations()
+	delete(annotations, knativeMinScaleAnnotation)
+	delete(annotations, knativeMaxScaleAnnotation)
+	deepCopy.SetAnnotations(annotations)
+
+	return c.Update(ctx, deepCopy)
+}
@@ -18,22 +18,28 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/go-logr/logr"
 	"strings"
 	"time"
 
 	"github.com/gorhill/cronexpr"
+	"github.com/prometheus/client_golang/prometheus"
 	corev1alpha1 "github.com/rekuberate-io/sleepcycles/api/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
 const (
@@ -45,6 +51,16 @@ type SleepCycleReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
 	logger logr.Logger
+
+	// EventRecorder emits Kubernetes Events on scale/suspend actions.
+	// Defaults to mgr.GetEventRecorderFor("sleepcycle-controller") in
+	// SetupWithManager if left unset.
+	EventRecorder record.EventRecorder
+
+	// MetricsRegisterer is where the sleepcycle_* Prometheus collectors are
+	// registered. Defaults to controller-runtime's global metrics.Registry
+	// in SetupWithManager if left unset.
+	MetricsRegisterer prometheus.Registerer
 }
 
 const (
@@ -84,10 +100,20 @@ func (r *SleepCycleReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, nil
 	}
 
+	if sleepCycle.Spec.Paused {
+		r.logger.Info("⏸️  SleepCycle is paused, skipping")
+		return ctrl.Result{}, nil
+	}
+
+	reconcileStart := time.Now()
+	defer func() {
+		reconcileDurationSeconds.WithLabelValues(sleepCycle.Namespace, sleepCycle.Name).Observe(time.Since(reconcileStart).Seconds())
+	}()
+
 	var updateSleepCycleStatus = false
 	var reconciliationSuccess = true
+	var drainRequeueAfter time.Duration
 	sleepCycleFullName := fmt.Sprintf("%v/%v", sleepCycle.Namespace, sleepCycle.Name)
-	currentOperation := r.GetCurrentScheduledOperation(sleepCycle)
 
 	deepCopy := *sleepCycle.DeepCopy()
 	if deepCopy.Status.UsedBy == nil {
@@ -95,54 +121,128 @@ func (r *SleepCycleReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		deepCopy.Status.UsedBy = usedBy
 	}
 
-	r.logger = r.logger.WithValues("op", currentOperation.String())
-
-	if !isEarlierThanScheduled || !sleepCycle.Status.LastReconciliationLoopSuccess {
-		var err error
-
-		_, err = r.ReconcileDeployments(ctx, req, &sleepCycle, &deepCopy, &updateSleepCycleStatus, currentOperation)
-		if err != nil {
-			reconciliationSuccess = false
-			updateSleepCycleStatus = true
+	currentOperation, err := r.GetCurrentScheduledOperation(sleepCycle)
+	if err != nil {
+		reason := corev1alpha1.ConditionReasonInvalidTimeZone
+		var parseErr *cronParseError
+		if errors.As(err, &parseErr) {
+			reason = corev1alpha1.ConditionReasonCronParseError
 		}
 
-		_, err = r.ReconcileCronJobs(ctx, req, &sleepCycle, &updateSleepCycleStatus, currentOperation)
-		if err != nil {
-			reconciliationSuccess = false
-			updateSleepCycleStatus = true
+		r.logger.Error(err, "🛑️ unable to resolve schedule", "sleepcycle", sleepCycleFullName)
+		meta.SetStatusCondition(&deepCopy.Status.Conditions, metav1.Condition{
+			Type:               corev1alpha1.ConditionTypeDegraded,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: sleepCycle.Generation,
+			Reason:             reason,
+			Message:            err.Error(),
+		})
+		if statusErr := r.Status().Update(ctx, &deepCopy); statusErr != nil {
+			r.logger.Error(statusErr, "🛑️ failed to update SleepCycle Status", "sleepcycle", sleepCycleFullName)
 		}
+		return ctrl.Result{}, err
+	}
 
-		_, err = r.ReconcileStatefulSets(ctx, req, &sleepCycle, &deepCopy, &updateSleepCycleStatus, currentOperation)
-		if err != nil {
-			reconciliationSuccess = false
-			updateSleepCycleStatus = true
-		}
+	r.logger = r.logger.WithValues("op", currentOperation.String())
 
-		_, err = r.ReconcileHorizontalPodAutoscalers(ctx, req, &sleepCycle, &deepCopy, &updateSleepCycleStatus, currentOperation)
-		if err != nil {
-			reconciliationSuccess = false
-			updateSleepCycleStatus = true
+	wasReady := meta.IsStatusConditionTrue(sleepCycle.Status.Conditions, corev1alpha1.ConditionTypeReady)
+	if !isEarlierThanScheduled || !wasReady {
+		for _, runner := range Runners() {
+			result, err := r.ReconcileRunner(ctx, runner, req, &sleepCycle, &deepCopy, &updateSleepCycleStatus, currentOperation)
+			if err != nil {
+				reconciliationSuccess = false
+				updateSleepCycleStatus = true
+			}
+			if result.RequeueAfter > 0 {
+				drainRequeueAfter = result.RequeueAfter
+			}
 		}
 	}
 
 	if updateSleepCycleStatus {
-		nextScheduledShutdown, nextScheduledWakeup := r.GetSchedulesTime(sleepCycle, false)
+		nextScheduledShutdown, nextScheduledWakeup, err := r.GetSchedulesTime(sleepCycle, false)
+		if err != nil {
+			r.logger.Error(err, "🛑️ unable to resolve schedule", "sleepcycle", sleepCycleFullName)
+			return ctrl.Result{}, err
+		}
 		deepCopy.Status.NextScheduledShutdownTime = &metav1.Time{Time: *nextScheduledShutdown}
 		deepCopy.Status.LastReconciliationLoop = &metav1.Time{Time: time.Now()}
-		deepCopy.Status.LastReconciliationLoopSuccess = reconciliationSuccess
+		nextShutdownTimestampSeconds.WithLabelValues(sleepCycle.Namespace, sleepCycle.Name).Set(float64(nextScheduledShutdown.Unix()))
 
 		if nextScheduledWakeup != nil {
 			deepCopy.Status.NextScheduledWakeupTime = &metav1.Time{Time: *nextScheduledWakeup}
 		}
 
+		readyCondition := metav1.Condition{
+			Type:               corev1alpha1.ConditionTypeReady,
+			ObservedGeneration: sleepCycle.Generation,
+			Reason:             corev1alpha1.ConditionReasonOnline,
+			Message:            "reconciliation completed successfully",
+		}
+		if currentOperation == Shutdown {
+			readyCondition.Reason = corev1alpha1.ConditionReasonOffline
+		}
+		if reconciliationSuccess {
+			readyCondition.Status = metav1.ConditionTrue
+		} else {
+			readyCondition.Status = metav1.ConditionFalse
+			readyCondition.Reason = corev1alpha1.ConditionReasonWorkloadScaleFailed
+			readyCondition.Message = "one or more workloads failed to shut down or wake up, see the controller logs"
+		}
+		meta.SetStatusCondition(&deepCopy.Status.Conditions, readyCondition)
+
+		degradedCondition := metav1.Condition{
+			Type:               corev1alpha1.ConditionTypeDegraded,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: sleepCycle.Generation,
+			Reason:             readyCondition.Reason,
+			Message:            readyCondition.Message,
+		}
+		if !reconciliationSuccess {
+			degradedCondition.Status = metav1.ConditionTrue
+		}
+		meta.SetStatusCondition(&deepCopy.Status.Conditions, degradedCondition)
+
+		meta.SetStatusCondition(&deepCopy.Status.Conditions, metav1.Condition{
+			Type:               corev1alpha1.ConditionTypeShutdown,
+			Status:             shutdownConditionStatus(currentOperation),
+			ObservedGeneration: sleepCycle.Generation,
+			Reason:             readyCondition.Reason,
+			Message:            readyCondition.Message,
+		})
+
+		progressingCondition := metav1.Condition{
+			Type:               corev1alpha1.ConditionTypeProgressing,
+			ObservedGeneration: sleepCycle.Generation,
+			Status:             metav1.ConditionFalse,
+			Reason:             readyCondition.Reason,
+			Message:            "no graceful drain in flight",
+		}
+		if drainRequeueAfter > 0 {
+			progressingCondition.Status = metav1.ConditionTrue
+			progressingCondition.Reason = corev1alpha1.ConditionReasonDraining
+			progressingCondition.Message = fmt.Sprintf("graceful drain stepping down, next step in %v", drainRequeueAfter)
+		}
+		meta.SetStatusCondition(&deepCopy.Status.Conditions, progressingCondition)
+
 		if err := r.Status().Update(ctx, &deepCopy); err != nil {
 			r.logger.Error(err, "🛑️ failed to update SleepCycle Status", "sleepcycle", sleepCycleFullName)
 			return ctrl.Result{}, err
 		}
 	}
 
+	if drainRequeueAfter > 0 {
+		r.logger.Info("🐢 Requeue for graceful drain step", "after", drainRequeueAfter)
+
+		return ctrl.Result{RequeueAfter: drainRequeueAfter}, nil
+	}
+
 	if updateSleepCycleStatus {
-		nextOperation, requeueAfter := r.GetNextScheduledOperation(sleepCycle)
+		nextOperation, requeueAfter, err := r.GetNextScheduledOperation(sleepCycle)
+		if err != nil {
+			r.logger.Error(err, "🛑️ unable to resolve schedule", "sleepcycle", sleepCycleFullName)
+			return ctrl.Result{}, err
+		}
 		r.logger.Info("🔁 Requeue", "next-op", nextOperation.String(), "after", requeueAfter)
 
 		return ctrl.Result{RequeueAfter: requeueAfter}, nil
@@ -217,16 +317,31 @@ func (r *SleepCycleReconciler) WatchDeploymentsHandler(o client.Object) []ctrl.R
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *SleepCycleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.EventRecorder == nil {
+		r.EventRecorder = mgr.GetEventRecorderFor("sleepcycle-controller")
+	}
+
+	if r.MetricsRegisterer == nil {
+		r.MetricsRegisterer = ctrlmetrics.Registry
+	}
+	registerMetrics(r.MetricsRegisterer)
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&corev1alpha1.SleepCycle{}).
 		Complete(r)
 }
 
-func (r *SleepCycleReconciler) GetCurrentScheduledOperation(sleepCycle corev1alpha1.SleepCycle) (nextScheduledOperation SleepCycleOperation) {
+func (r *SleepCycleReconciler) GetCurrentScheduledOperation(sleepCycle corev1alpha1.SleepCycle) (nextScheduledOperation SleepCycleOperation, err error) {
 
 	nextScheduledOperation = Watch
-	nextScheduledShutdown, nextScheduledWakeup := r.GetSchedulesTime(sleepCycle, true)
-	shutdownTimeWindow, wakeupTimeWindow := r.GetScheduleTimeWindows(sleepCycle, true)
+	nextScheduledShutdown, nextScheduledWakeup, err := r.GetSchedulesTime(sleepCycle, true)
+	if err != nil {
+		return nextScheduledOperation, err
+	}
+	shutdownTimeWindow, wakeupTimeWindow, err := r.GetScheduleTimeWindows(sleepCycle, true)
+	if err != nil {
+		return nextScheduledOperation, err
+	}
 
 	var isWithinScheduleForShutdown, isWithinScheduleForWakeup = false, false
 
@@ -238,30 +353,36 @@ func (r *SleepCycleReconciler) GetCurrentScheduledOperation(sleepCycle corev1alp
 
 	if nextScheduledWakeup == nil {
 		nextScheduledOperation = Shutdown
-		return nextScheduledOperation
+		return nextScheduledOperation, nil
 	}
 
 	if nextScheduledShutdown.Before(*nextScheduledWakeup) && isWithinScheduleForShutdown {
 		nextScheduledOperation = Shutdown
-		return nextScheduledOperation
+		return nextScheduledOperation, nil
 	}
 
 	if nextScheduledWakeup.Before(*nextScheduledShutdown) && isWithinScheduleForWakeup {
 		nextScheduledOperation = WakeUp
-		return nextScheduledOperation
+		return nextScheduledOperation, nil
 	}
 
 	if isWithinScheduleForShutdown && isWithinScheduleForWakeup {
 		nextScheduledOperation = WakeUp
 	}
 
-	return nextScheduledOperation
+	return nextScheduledOperation, nil
 }
 
-func (r *SleepCycleReconciler) GetNextScheduledOperation(sleepCycle corev1alpha1.SleepCycle) (SleepCycleOperation, time.Duration) {
+func (r *SleepCycleReconciler) GetNextScheduledOperation(sleepCycle corev1alpha1.SleepCycle) (SleepCycleOperation, time.Duration, error) {
 	var requeueAfter time.Duration
-	currentOperation := r.GetCurrentScheduledOperation(sleepCycle)
-	nextScheduledShutdown, nextScheduledWakeup := r.GetSchedulesTime(sleepCycle, false)
+	currentOperation, err := r.GetCurrentScheduledOperation(sleepCycle)
+	if err != nil {
+		return Watch, 0, err
+	}
+	nextScheduledShutdown, nextScheduledWakeup, err := r.GetSchedulesTime(sleepCycle, false)
+	if err != nil {
+		return Watch, 0, err
+	}
 	var nextOperation SleepCycleOperation
 
 	switch currentOperation {
@@ -291,11 +412,14 @@ func (r *SleepCycleReconciler) GetNextScheduledOperation(sleepCycle corev1alpha1
 		requeueAfter = time.Until(*nextScheduledShutdown)
 	}
 
-	return nextOperation, requeueAfter
+	return nextOperation, requeueAfter, nil
 }
 
-func (r *SleepCycleReconciler) GetScheduleTimeWindows(sleepCycle corev1alpha1.SleepCycle, useStatus bool) (shutdown *TimeWindow, wakeup *TimeWindow) {
-	nextScheduledShutdown, nextScheduledWakeup := r.GetSchedulesTime(sleepCycle, useStatus)
+func (r *SleepCycleReconciler) GetScheduleTimeWindows(sleepCycle corev1alpha1.SleepCycle, useStatus bool) (shutdown *TimeWindow, wakeup *TimeWindow, err error) {
+	nextScheduledShutdown, nextScheduledWakeup, err := r.GetSchedulesTime(sleepCycle, useStatus)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	shutdown = NewTimeWindow(*nextScheduledShutdown)
 
@@ -303,44 +427,86 @@ func (r *SleepCycleReconciler) GetScheduleTimeWindows(sleepCycle corev1alpha1.Sl
 		wakeup = NewTimeWindow(*nextScheduledWakeup)
 	}
 
-	return shutdown, wakeup
+	return shutdown, wakeup, nil
 }
 
-func (r *SleepCycleReconciler) GetSchedulesTime(sleepCycle corev1alpha1.SleepCycle, useStatus bool) (shutdown *time.Time, wakeup *time.Time) {
+// GetSchedulesTime computes the next Shutdown/WakeUp firing times, evaluating
+// both cron expressions against the wall clock of sleepCycle's Spec.TimeZone
+// (UTC if unset) rather than the controller pod's local time zone. Resolving
+// time.Now() into that location before handing it to cronexpr.Next() is what
+// keeps the schedule DST-safe across zones that observe it.
+func (r *SleepCycleReconciler) GetSchedulesTime(sleepCycle corev1alpha1.SleepCycle, useStatus bool) (shutdown *time.Time, wakeup *time.Time, err error) {
+
+	loc, err := timeZoneLocation(sleepCycle)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	shutdown = nil
 	wakeup = nil
 
+	shutdownCronExpression, parseErr := cronexpr.Parse(sleepCycle.Spec.Shutdown)
+	if parseErr != nil {
+		return nil, nil, &cronParseError{field: "shutdown", err: parseErr}
+	}
+
 	if useStatus {
 		if sleepCycle.Status.NextScheduledShutdownTime != nil {
 			shutdown = &sleepCycle.Status.NextScheduledShutdownTime.Time
 		} else {
-			t := cronexpr.MustParse(sleepCycle.Spec.Shutdown).Next(time.Now())
+			t := firstOccurrence(shutdownCronExpression.Next(time.Now().In(loc)))
 			shutdown = &t
 		}
 
 		if sleepCycle.Status.NextScheduledWakeupTime != nil {
 			wakeup = &sleepCycle.Status.NextScheduledWakeupTime.Time
-		} else {
-			wakeupCronExpression, err := cronexpr.Parse(sleepCycle.Spec.WakeUp)
-			if err == nil {
-				t := wakeupCronExpression.Next(time.Now())
-				wakeup = &t
+		} else if sleepCycle.Spec.WakeUp != "" {
+			wakeupCronExpression, parseErr := cronexpr.Parse(sleepCycle.Spec.WakeUp)
+			if parseErr != nil {
+				return nil, nil, &cronParseError{field: "wakeUp", err: parseErr}
 			}
+			t := firstOccurrence(wakeupCronExpression.Next(time.Now().In(loc)))
+			wakeup = &t
 		}
 	} else {
-		t := cronexpr.MustParse(sleepCycle.Spec.Shutdown).Next(time.Now())
+		t := firstOccurrence(shutdownCronExpression.Next(time.Now().In(loc)))
 		shutdown = &t
-		wakeupCronExpression, err := cronexpr.Parse(sleepCycle.Spec.WakeUp)
-		if err == nil {
-			t := wakeupCronExpression.Next(time.Now())
+
+		if sleepCycle.Spec.WakeUp != "" {
+			wakeupCronExpression, parseErr := cronexpr.Parse(sleepCycle.Spec.WakeUp)
+			if parseErr != nil {
+				return nil, nil, &cronParseError{field: "wakeUp", err: parseErr}
+			}
+			t := firstOccurrence(wakeupCronExpression.Next(time.Now().In(loc)))
 			wakeup = &t
 		}
 	}
 
-	return shutdown, wakeup
+	return shutdown, wakeup, nil
 }
 
+// cronParseError wraps a cron expression parse failure on field ("shutdown"
+// or "wakeUp") so Reconcile can tell it apart from GetSchedulesTime's other
+// failure mode, an invalid Spec.TimeZone, and set the matching Degraded
+// condition Reason.
+type cronParseError struct {
+	field string
+	err   error
+}
+
+func (e *cronParseError) Error() string {
+	return fmt.Sprintf("spec.%s: %v", e.field, e.err)
+}
+
+func (e *cronParseError) Unwrap() error {
+	return e.err
+}
+
+// IsEarlierThanScheduled compares time.Now() against the already-computed
+// Status.NextScheduled*Time values. Those are absolute instants, so unlike
+// GetSchedulesTime this comparison needs no Spec.TimeZone resolution: two
+// time.Time values compare correctly regardless of which location produced
+// them.
 func (r *SleepCycleReconciler) IsEarlierThanScheduled(sleepCycle corev1alpha1.SleepCycle) bool {
 	now := metav1.Time{Time: time.Now()}
 
@@ -369,231 +535,141 @@ func (r *SleepCycleReconciler) IsTagged(obj *metav1.ObjectMeta, tag string) bool
 	return false
 }
 
-func (r *SleepCycleReconciler) ReconcileDeployments(
+// ReconcileRunner drives runner's Shutdown/WakeUp over every object it lists
+// in req.Namespace that is tagged with sleepCycle.Name, tracking each
+// object's pre-shutdown State in deepCopy.Status.UsedBy so WakeUp can later
+// restore it. State is tracked per Kind so two runners never collide over a
+// workload that happens to share a name.
+func (r *SleepCycleReconciler) ReconcileRunner(
 	ctx context.Context,
+	runner Runner,
 	req ctrl.Request,
 	sleepCycle *corev1alpha1.SleepCycle,
 	deepCopy *corev1alpha1.SleepCycle,
 	update *bool,
 	op SleepCycleOperation,
 ) (ctrl.Result, error) {
-	deploymentList := appsv1.DeploymentList{}
-	if err := r.List(ctx, &deploymentList, &client.ListOptions{Namespace: req.NamespacedName.Namespace}); err != nil {
+	objs, err := runner.List(ctx, r.Client, req.NamespacedName.Namespace)
+	if err != nil {
 		return ctrl.Result{}, err
 	}
 
-	r.logger.Info("📚 Processing Deployments")
-
-	for _, deployment := range deploymentList.Items {
-		hasSleepCycle := r.IsTagged(&deployment.ObjectMeta, sleepCycle.Name)
+	r.logger.Info("📚 Processing workloads", "kind", runner.Kind().Kind)
 
-		if hasSleepCycle {
-			*update = true
-			deploymentFullName := fmt.Sprintf("%v/%v", deployment.Namespace, deployment.Name)
-			deepCopy.Status.Enabled = sleepCycle.Spec.Enabled
+	var tagged int
 
-			currentReplicas := int(deployment.Status.Replicas)
-			val, ok := deepCopy.Status.UsedBy[deploymentFullName]
-			if ok && val < currentReplicas && currentReplicas > 0 {
-				deepCopy.Status.UsedBy[deploymentFullName] = currentReplicas
-			}
-
-			switch op {
-			case Watch:
-			case Shutdown:
-				if deployment.Status.Replicas != 0 {
-					r.logger.Info("⬇  Scale Down Deployment", "deployment", deploymentFullName, "targetReplicas", 0)
-
-					err := r.ScaleDeployment(ctx, deployment, 0)
-					if err != nil {
-						r.logger.Error(err, "🛑️ Scaling Deployment failed", "deployment", deploymentFullName)
-						return ctrl.Result{}, err
-					}
-				}
-			case WakeUp:
-				targetReplicas := int32(deepCopy.Status.UsedBy[deploymentFullName])
-
-				if deployment.Status.Replicas != targetReplicas {
-					r.logger.Info("⬆  Scale Up Deployment", "deployment", deploymentFullName, "targetReplicas", targetReplicas)
-
-					err := r.ScaleDeployment(ctx, deployment, targetReplicas)
-					if err != nil {
-						r.logger.Error(err, "🛑️ Scaling Deployment failed", "deployment", deploymentFullName)
-						return ctrl.Result{}, err
-					}
-				}
-			}
+	for _, obj := range objs {
+		if !r.IsTagged(objectMeta(obj), sleepCycle.Name) {
+			continue
 		}
-	}
-
-	return ctrl.Result{}, nil
-}
 
-func (r *SleepCycleReconciler) ReconcileCronJobs(ctx context.Context,
-	req ctrl.Request,
-	sleepCycle *corev1alpha1.SleepCycle,
-	update *bool,
-	op SleepCycleOperation,
-) (ctrl.Result, error) {
-	cronJobList := batchv1.CronJobList{}
-	if err := r.List(ctx, &cronJobList, &client.ListOptions{Namespace: req.NamespacedName.Namespace}); err != nil {
-		return ctrl.Result{}, err
-	}
-
-	r.logger.Info("🕑 Processing CronJobs")
-
-	for _, cronJob := range cronJobList.Items {
-		hasSleepCycle := r.IsTagged(&cronJob.ObjectMeta, sleepCycle.Name)
-
-		if hasSleepCycle {
-			*update = true
-			cronJobFullName := fmt.Sprintf("%v/%v", cronJob.Namespace, cronJob.Name)
-
-			switch op {
-			case Watch:
-			case Shutdown:
-				if !*cronJob.Spec.Suspend {
-					r.logger.Info("⬇  Suspending CronJob", "cronJob", cronJobFullName)
-
-					err := r.SuspendCronJob(ctx, cronJob, true)
-					if err != nil {
-						r.logger.Error(err, "🛑️️ Suspending CronJob failed", "cronJob", cronJobFullName)
-						return ctrl.Result{}, err
-					}
-				}
-			case WakeUp:
-				if *cronJob.Spec.Suspend {
-					r.logger.Info("⬆  Enabling Cronjob", "cronJob", cronJobFullName)
-
-					err := r.SuspendCronJob(ctx, cronJob, false)
-					if err != nil {
-						r.logger.Error(err, "🛑️️ Suspending CronJob failed", "cronJob", cronJobFullName)
-						return ctrl.Result{}, err
-					}
-				}
-			}
+		*update = true
+		tagged++
+		fullName := fmt.Sprintf("%v/%v/%v", runner.Kind().Kind, obj.GetNamespace(), obj.GetName())
+		legacyName := fmt.Sprintf("%v/%v", obj.GetNamespace(), obj.GetName())
+		migrateUsedByKey(deepCopy.Status.UsedBy, legacyName, fullName)
+		deepCopy.Status.Enabled = sleepCycle.Spec.Enabled
+
+		currentState := int(runner.SnapshotState(obj))
+		val, ok := deepCopy.Status.UsedBy[fullName]
+		if currentState > 0 && (!ok || val < currentState) {
+			deepCopy.Status.UsedBy[fullName] = currentState
 		}
-	}
 
-	return ctrl.Result{}, nil
-}
+		switch op {
+		case Watch:
+		case Shutdown:
+			r.logger.Info("⬇  Shutting down workload", "kind", runner.Kind().Kind, "workload", fullName)
 
-func (r *SleepCycleReconciler) ReconcileStatefulSets(
-	ctx context.Context,
-	req ctrl.Request,
-	sleepCycle *corev1alpha1.SleepCycle,
-	deepCopy *corev1alpha1.SleepCycle,
-	update *bool,
-	op SleepCycleOperation,
-) (ctrl.Result, error) {
-	statefulSetList := appsv1.StatefulSetList{}
-	if err := r.List(ctx, &statefulSetList, &client.ListOptions{Namespace: req.NamespacedName.Namespace}); err != nil {
-		return ctrl.Result{}, err
-	}
+			requeueAfter, err := runner.Shutdown(ctx, r.Client, obj, sleepCycle)
+			if err != nil {
+				r.logger.Error(err, "🛑️ Shutting down workload failed", "kind", runner.Kind().Kind, "workload", fullName)
+				r.recordEvent(sleepCycle, obj, corev1.EventTypeWarning, "ShutdownFailed", fmt.Sprintf("failed to shut down %v: %v", fullName, err))
+				return ctrl.Result{}, err
+			}
 
-	r.logger.Info("📦 Processing StatefulSets")
+			shutdownTotal.WithLabelValues(obj.GetNamespace(), sleepCycle.Name, runner.Kind().Kind).Inc()
+			r.recordEvent(sleepCycle, obj, corev1.EventTypeNormal, "ShutdownSucceeded", fmt.Sprintf("shut down %v", fullName))
 
-	for _, statefulSet := range statefulSetList.Items {
-		hasSleepCycle := r.IsTagged(&statefulSet.ObjectMeta, sleepCycle.Name)
+			if requeueAfter > 0 {
+				return ctrl.Result{RequeueAfter: requeueAfter}, nil
+			}
+		case WakeUp:
+			previousState := State(deepCopy.Status.UsedBy[fullName])
 
-		if hasSleepCycle {
-			*update = true
-			statefulSetFullName := fmt.Sprintf("%v/%v", statefulSet.Namespace, statefulSet.Name)
-			deepCopy.Status.Enabled = sleepCycle.Spec.Enabled
+			r.logger.Info("⬆  Waking up workload", "kind", runner.Kind().Kind, "workload", fullName, "targetState", previousState)
 
-			currentReplicas := int(statefulSet.Status.Replicas)
-			val, ok := deepCopy.Status.UsedBy[statefulSetFullName]
-			if ok && val < currentReplicas && currentReplicas > 0 {
-				deepCopy.Status.UsedBy[statefulSetFullName] = currentReplicas
+			if err := runner.WakeUp(ctx, r.Client, obj, sleepCycle, previousState); err != nil {
+				r.logger.Error(err, "🛑️ Waking up workload failed", "kind", runner.Kind().Kind, "workload", fullName)
+				r.recordEvent(sleepCycle, obj, corev1.EventTypeWarning, "WakeUpFailed", fmt.Sprintf("failed to wake up %v: %v", fullName, err))
+				return ctrl.Result{}, err
 			}
 
-			switch op {
-			case Watch:
-			case Shutdown:
-				if statefulSet.Status.Replicas != 0 {
-					r.logger.Info("⬇  Scale Down StatefulSet", "statefulSet", statefulSetFullName, "targetReplicas", 0)
-
-					err := r.ScaleStatefulSet(ctx, statefulSet, 0)
-					if err != nil {
-						r.logger.Error(err, "🛑️ Scaling StatefulSet failed", "statefulSet", statefulSetFullName)
-						return ctrl.Result{}, err
-					}
-				}
-			case WakeUp:
-				targetReplicas := int32(deepCopy.Status.UsedBy[statefulSetFullName])
-
-				if statefulSet.Status.Replicas != targetReplicas {
-					r.logger.Info("⬆  Scale Up StatefulSet", "statefulSet", statefulSetFullName, "targetReplicas", targetReplicas)
-
-					err := r.ScaleStatefulSet(ctx, statefulSet, targetReplicas)
-					if err != nil {
-						r.logger.Error(err, "🛑️ Scaling StatefulSet failed", "statefulSet", statefulSetFullName)
-						return ctrl.Result{}, err
-					}
-				}
-			}
+			wakeupTotal.WithLabelValues(obj.GetNamespace(), sleepCycle.Name, runner.Kind().Kind).Inc()
+			r.recordEvent(sleepCycle, obj, corev1.EventTypeNormal, "WakeUpSucceeded", fmt.Sprintf("woke up %v", fullName))
 		}
 	}
 
+	workloadsManaged.WithLabelValues(req.NamespacedName.Namespace, sleepCycle.Name, runner.Kind().Kind).Set(float64(tagged))
+
 	return ctrl.Result{}, nil
 }
 
-func (r *SleepCycleReconciler) ReconcileHorizontalPodAutoscalers(
-	ctx context.Context,
-	req ctrl.Request,
-	sleepCycle *corev1alpha1.SleepCycle,
-	deepCopy *corev1alpha1.SleepCycle,
-	update *bool,
-	op SleepCycleOperation,
-) (ctrl.Result, error) {
-	hpaList := autoscalingv1.HorizontalPodAutoscalerList{}
-	if err := r.List(ctx, &hpaList, &client.ListOptions{Namespace: req.NamespacedName.Namespace}); err != nil {
-		return ctrl.Result{}, err
+// recordEvent emits a Kubernetes Event against sleepCycle, noting which
+// tagged workload the action was for. A nil EventRecorder (e.g. in tests
+// constructing a SleepCycleReconciler directly) is a no-op.
+func (r *SleepCycleReconciler) recordEvent(sleepCycle *corev1alpha1.SleepCycle, obj client.Object, eventType, reason, message string) {
+	if r.EventRecorder == nil {
+		return
 	}
 
-	r.logger.Info("📈 Processing HorizontalPodAutoscalers")
+	r.EventRecorder.Event(sleepCycle, eventType, reason, message)
+}
 
-	for _, hpa := range hpaList.Items {
-		hasSleepCycle := r.IsTagged(&hpa.ObjectMeta, sleepCycle.Name)
+// migrateUsedByKey re-keys a pre-Runner-refactor Status.UsedBy entry (keyed
+// "<namespace>/<name>") onto its current "<Kind>/<namespace>/<name>" key, so
+// a SleepCycle upgrading from that controller version doesn't miss its
+// recorded replica count on the first WakeUp after upgrade. A no-op once the
+// migration has happened once, since the legacy key is deleted on the way.
+func migrateUsedByKey(usedBy map[string]int, legacyName, fullName string) {
+	if usedBy == nil {
+		return
+	}
 
-		if hasSleepCycle {
-			*update = true
-			hpaFullName := fmt.Sprintf("%v/%v", hpa.Namespace, hpa.Name)
-			deepCopy.Status.Enabled = sleepCycle.Spec.Enabled
+	if _, migrated := usedBy[fullName]; migrated {
+		return
+	}
 
-			maxReplicas := int(hpa.Spec.MaxReplicas)
-			val, ok := deepCopy.Status.UsedBy[hpaFullName]
-			if ok && val < maxReplicas && maxReplicas > 0 {
-				deepCopy.Status.UsedBy[hpaFullName] = maxReplicas
-			}
+	if legacyState, ok := usedBy[legacyName]; ok {
+		usedBy[fullName] = legacyState
+		delete(usedBy, legacyName)
+	}
+}
 
-			switch op {
-			case Watch:
-			case Shutdown:
-				if hpa.Spec.MaxReplicas != 1 {
-					r.logger.Info("⬇  Scale Down HorizontalPodAutoscaler", "hpa", hpaFullName, "maxReplicas", 1)
-
-					err := r.ScaleHorizontalPodAutoscaler(ctx, hpa, 1)
-					if err != nil {
-						r.logger.Error(err, "🛑️ Scaling HorizontalPodAutoscaler failed", "hpa", hpaFullName)
-						return ctrl.Result{}, err
-					}
-				}
-			case WakeUp:
-				targetReplicas := int32(deepCopy.Status.UsedBy[hpaFullName])
-
-				if hpa.Spec.MaxReplicas != targetReplicas {
-					r.logger.Info("⬆  Scale Up HorizontalPodAutoscaler", "hpa", hpaFullName, "maxReplicas", targetReplicas)
-
-					err := r.ScaleHorizontalPodAutoscaler(ctx, hpa, targetReplicas)
-					if err != nil {
-						r.logger.Error(err, "🛑️ Scaling HorizontalPodAutoscaler failed", "hpa", hpaFullName)
-						return ctrl.Result{}, err
-					}
-				}
-			}
-		}
+func shutdownConditionStatus(op SleepCycleOperation) metav1.ConditionStatus {
+	if op == Shutdown {
+		return metav1.ConditionTrue
 	}
+	return metav1.ConditionFalse
+}
 
-	return ctrl.Result{}, nil
+func objectMeta(obj client.Object) *metav1.ObjectMeta {
+	switch o := obj.(type) {
+	case *appsv1.Deployment:
+		return &o.ObjectMeta
+	case *appsv1.StatefulSet:
+		return &o.ObjectMeta
+	case *batchv1.CronJob:
+		return &o.ObjectMeta
+	case *autoscalingv1.HorizontalPodAutoscaler:
+		return &o.ObjectMeta
+	default:
+		accessor := metav1.ObjectMeta{
+			Name:        obj.GetName(),
+			Namespace:   obj.GetNamespace(),
+			Labels:      obj.GetLabels(),
+			Annotations: obj.GetAnnotations(),
+		}
+		return &accessor
+	}
 }
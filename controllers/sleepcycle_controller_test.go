@@ -0,0 +1,78 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"errors"
+	"testing"
+
+	corev1alpha1 "github.com/rekuberate-io/sleepcycles/api/v1alpha1"
+)
+
+// TestGetSchedulesTimeCronParseError verifies that a malformed Spec.Shutdown
+// or Spec.WakeUp surfaces as a *cronParseError, instead of panicking via
+// cronexpr.MustParse, so Reconcile can set ConditionReasonCronParseError
+// rather than crashing.
+func TestGetSchedulesTimeCronParseError(t *testing.T) {
+	r := &SleepCycleReconciler{}
+
+	t.Run("invalid shutdown", func(t *testing.T) {
+		sleepCycle := corev1alpha1.SleepCycle{
+			Spec: corev1alpha1.SleepCycleSpec{Shutdown: "not a cron expression"},
+		}
+
+		_, _, err := r.GetSchedulesTime(sleepCycle, false)
+
+		var parseErr *cronParseError
+		if !errors.As(err, &parseErr) {
+			t.Fatalf("expected a *cronParseError, got %v", err)
+		}
+		if parseErr.field != "shutdown" {
+			t.Fatalf("expected the error to name field \"shutdown\", got %q", parseErr.field)
+		}
+	})
+
+	t.Run("invalid wakeup", func(t *testing.T) {
+		sleepCycle := corev1alpha1.SleepCycle{
+			Spec: corev1alpha1.SleepCycleSpec{Shutdown: "0 20 * * *", WakeUp: "not a cron expression"},
+		}
+
+		_, _, err := r.GetSchedulesTime(sleepCycle, false)
+
+		var parseErr *cronParseError
+		if !errors.As(err, &parseErr) {
+			t.Fatalf("expected a *cronParseError, got %v", err)
+		}
+		if parseErr.field != "wakeUp" {
+			t.Fatalf("expected the error to name field \"wakeUp\", got %q", parseErr.field)
+		}
+	})
+
+	t.Run("valid schedule", func(t *testing.T) {
+		sleepCycle := corev1alpha1.SleepCycle{
+			Spec: corev1alpha1.SleepCycleSpec{Shutdown: "0 20 * * *", WakeUp: "0 8 * * *"},
+		}
+
+		shutdown, wakeup, err := r.GetSchedulesTime(sleepCycle, false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if shutdown == nil || wakeup == nil {
+			t.Fatalf("expected both shutdown and wakeup times, got shutdown=%v wakeup=%v", shutdown, wakeup)
+		}
+	})
+}
@@ -0,0 +1,26 @@
+package controllers
+
+import "time"
+
+// TimeWindow is a tolerance window around a scheduled time, used to decide
+// whether "now" is close enough to a scheduled shutdown/wakeup to act on it.
+type TimeWindow struct {
+	start time.Time
+	end   time.Time
+}
+
+// NewTimeWindow builds a TimeWindow centered on scheduled, extending
+// TimeWindowToleranceInSeconds on either side.
+func NewTimeWindow(scheduled time.Time) *TimeWindow {
+	tolerance := time.Duration(TimeWindowToleranceInSeconds) * time.Second
+
+	return &TimeWindow{
+		start: scheduled.Add(-tolerance),
+		end:   scheduled.Add(tolerance),
+	}
+}
+
+// IsScheduleWithinWindow reports whether now falls within the window.
+func (w *TimeWindow) IsScheduleWithinWindow(now time.Time) bool {
+	return !now.Before(w.start) && !now.After(w.end)
+}
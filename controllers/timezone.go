@@ -0,0 +1,68 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"time"
+
+	corev1alpha1 "github.com/rekuberate-io/sleepcycles/api/v1alpha1"
+)
+
+// DefaultTimeZone is used when a SleepCycle does not set Spec.TimeZone. For
+// SleepCycles created before this field existed, it matches the earlier
+// pod-local behaviour only if the controller pod ran in UTC.
+const DefaultTimeZone = "UTC"
+
+// timeZoneLocation resolves sleepCycle's Spec.TimeZone to a *time.Location,
+// falling back to DefaultTimeZone when it is unset. cronexpr evaluates its
+// Next() calls against the wall clock of the time.Time it is given, so
+// loading the IANA zone here and calling time.Now().In(loc) before every
+// Next() is what makes the schedule DST-safe: Go's time package already
+// skips the missing hour on a spring-forward transition. firstOccurrence
+// then corrects Next()'s result on a fall-back transition, where Go's
+// default resolution of an ambiguous wall-clock hour is its second, later
+// occurrence - the opposite of the "fire once, at the first occurrence"
+// behaviour a schedule needs.
+func timeZoneLocation(sleepCycle corev1alpha1.SleepCycle) (*time.Location, error) {
+	tz := sleepCycle.Spec.TimeZone
+	if tz == "" {
+		tz = DefaultTimeZone
+	}
+
+	return time.LoadLocation(tz)
+}
+
+// firstOccurrence corrects a cronexpr Next() result that landed on a
+// fall-back transition's doubled hour. Go resolves an ambiguous wall-clock
+// time to its second (standard-time, later-UTC-offset) occurrence; if the
+// instant exactly one hour earlier has the same wall clock but a larger UTC
+// offset (summer time), that earlier instant is the doubled hour's first
+// occurrence, and the one that should fire.
+func firstOccurrence(t time.Time) time.Time {
+	earlier := t.Add(-time.Hour)
+	if earlier.Day() != t.Day() || earlier.Hour() != t.Hour() || earlier.Minute() != t.Minute() {
+		return t
+	}
+
+	_, tOffset := t.Zone()
+	_, earlierOffset := earlier.Zone()
+	if earlierOffset > tOffset {
+		return earlier
+	}
+
+	return t
+}
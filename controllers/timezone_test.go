@@ -0,0 +1,93 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gorhill/cronexpr"
+)
+
+// TestDSTSpringForward verifies that a schedule landing on the hour skipped
+// by a spring-forward transition fires at the next valid wall-clock minute,
+// which is the behaviour GetSchedulesTime's doc comment relies on.
+func TestDSTSpringForward(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Athens")
+	if err != nil {
+		t.Fatalf("unable to load Europe/Athens: %v", err)
+	}
+
+	// Athens springs forward at 2024-03-31 03:00 EET -> 04:00 EEST, so 03:30
+	// never exists that day.
+	before := time.Date(2024, 3, 31, 2, 0, 0, 0, loc)
+	expr := cronexpr.MustParse("30 3 * * *")
+
+	next := firstOccurrence(expr.Next(before))
+
+	if next.Month() != time.March || next.Day() != 31 || next.Hour() != 4 || next.Minute() != 30 {
+		t.Fatalf("expected the skipped 03:30 to roll forward to 04:30 EEST, got %v", next)
+	}
+}
+
+// TestDSTFallBack verifies that firstOccurrence corrects cronexpr's Next()
+// result on the hour repeated by a fall-back transition: Go resolves an
+// ambiguous wall clock to its second, later-offset occurrence by default,
+// but only the first occurrence should fire.
+func TestDSTFallBack(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Athens")
+	if err != nil {
+		t.Fatalf("unable to load Europe/Athens: %v", err)
+	}
+
+	// Athens falls back at 2024-10-27 04:00 EEST -> 03:00 EET, so 03:30
+	// happens twice that day: once at EEST (UTC+3), once at EET (UTC+2).
+	before := time.Date(2024, 10, 27, 2, 0, 0, 0, loc)
+	expr := cronexpr.MustParse("30 3 * * *")
+
+	raw := expr.Next(before)
+	if _, offset := raw.Zone(); offset != 2*60*60 {
+		t.Fatalf("expected cronexpr's uncorrected Next() to land on the second (EET, UTC+2) occurrence, got offset %ds", offset)
+	}
+
+	next := firstOccurrence(raw)
+	if next.Month() != time.October || next.Day() != 27 || next.Hour() != 3 || next.Minute() != 30 {
+		t.Fatalf("expected the doubled 03:30 to fire at 03:30, got %v", next)
+	}
+	if _, offset := next.Zone(); offset != 3*60*60 {
+		t.Fatalf("expected firstOccurrence to correct to the first (EEST, UTC+3) occurrence, got offset %ds", offset)
+	}
+
+	second := expr.Next(next)
+	if second.Day() != 28 {
+		t.Fatalf("expected the next match after the doubled hour to be the following day, got %v", second)
+	}
+}
+
+// TestFirstOccurrenceUnambiguous verifies firstOccurrence is a no-op outside
+// a fall-back transition's doubled hour.
+func TestFirstOccurrenceUnambiguous(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Athens")
+	if err != nil {
+		t.Fatalf("unable to load Europe/Athens: %v", err)
+	}
+
+	plain := time.Date(2024, 10, 28, 3, 30, 0, 0, loc)
+	if got := firstOccurrence(plain); !got.Equal(plain) {
+		t.Fatalf("expected firstOccurrence to leave an unambiguous time unchanged, got %v", got)
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command scalability spins up an envtest control plane, applies a
+// generator-config YAML describing N namespaces x M workloads, and reports
+// reconcile throughput so controller changes can be measured before and
+// after against reproducible numbers.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+
+	corev1alpha1 "github.com/rekuberate-io/sleepcycles/api/v1alpha1"
+	"github.com/rekuberate-io/sleepcycles/test/scalability"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+func main() {
+	configPath := flag.String("config", "", "path to the generator-config YAML")
+	format := flag.String("format", "json", "report format: json or csv")
+	output := flag.String("output", "", "path to write the report to (default: stdout)")
+	withCPUProfile := flag.String("withCPUProfile", "", "write a CPU profile to this path")
+	withLogs := flag.Bool("withLogs", false, "stream controller-runtime logs to stderr")
+	seed := flag.Int64("seed", 1, "seed for the randomized workload generator")
+	flag.Parse()
+
+	if *configPath == "" {
+		log.Fatal("--config is required")
+	}
+
+	if *withCPUProfile != "" {
+		f, err := os.Create(*withCPUProfile)
+		if err != nil {
+			log.Fatalf("unable to create CPU profile: %v", err)
+		}
+		defer f.Close()
+
+		if err := pprof.StartCPUProfile(f); err != nil {
+			log.Fatalf("unable to start CPU profile: %v", err)
+		}
+		defer pprof.StopCPUProfile()
+	}
+
+	if err := run(*configPath, *format, *output, *withLogs, *seed); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(configPath, format, output string, withLogs bool, seed int64) error {
+	config, err := scalability.LoadGeneratorConfig(configPath)
+	if err != nil {
+		return fmt.Errorf("loading generator config: %w", err)
+	}
+
+	testEnv := &envtest.Environment{
+		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "..", "config", "crd", "bases")},
+		ErrorIfCRDPathMissing: true,
+	}
+	restConfig, err := testEnv.Start()
+	if err != nil {
+		return fmt.Errorf("starting envtest: %w", err)
+	}
+	defer testEnv.Stop()
+
+	if err := corev1alpha1.AddToScheme(scheme.Scheme); err != nil {
+		return fmt.Errorf("registering scheme: %w", err)
+	}
+
+	c, err := client.New(restConfig, client.Options{Scheme: scheme.Scheme})
+	if err != nil {
+		return fmt.Errorf("building client: %w", err)
+	}
+
+	generator := scalability.NewGenerator(c, *config, seed)
+	recorder := scalability.NewRecorder()
+
+	namespaces, err := generator.Generate(context.Background())
+	if err != nil {
+		return fmt.Errorf("generating workloads: %w", err)
+	}
+
+	if withLogs {
+		ctrl.SetLogger(zap.New(zap.WriteTo(os.Stderr)))
+		fmt.Fprintf(os.Stderr, "generated %d namespaces\n", len(namespaces))
+	}
+
+	run, err := scalability.NewRunner(c, restConfig, recorder).Run(context.Background(), namespaces)
+	if err != nil {
+		return fmt.Errorf("running reconcile loop: %w", err)
+	}
+
+	var out io.Writer = os.Stdout
+	if output != "" {
+		f, err := os.Create(output)
+		if err != nil {
+			return fmt.Errorf("creating output file: %w", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	switch format {
+	case "csv":
+		return run.WriteCSV(out)
+	default:
+		return run.WriteJSON(out)
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package scalability generates synthetic workloads against an envtest
+// control plane and measures how the SleepCycle controller's reconcile loop
+// scales with the number of tagged namespaces and workloads.
+package scalability
+
+import (
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// WorkloadMix describes how many of each kind to generate per namespace.
+type WorkloadMix struct {
+	Deployments            int `yaml:"deployments"`
+	StatefulSets           int `yaml:"statefulSets"`
+	CronJobs               int `yaml:"cronJobs"`
+	HorizontalPodAutoscalers int `yaml:"horizontalPodAutoscalers"`
+}
+
+// GeneratorConfig is the YAML schema read from --config.
+type GeneratorConfig struct {
+	// Namespaces is how many namespaces to create (N).
+	Namespaces int `yaml:"namespaces"`
+
+	// WorkloadsPerNamespace is how many of each kind to create per
+	// namespace (M).
+	WorkloadsPerNamespace WorkloadMix `yaml:"workloadsPerNamespace"`
+
+	// MinReplicas and MaxReplicas bound the randomized starting replica
+	// count assigned to each generated workload.
+	MinReplicas int `yaml:"minReplicas"`
+	MaxReplicas int `yaml:"maxReplicas"`
+
+	// Schedules is a pool of cron expressions randomly assigned to the
+	// SleepCycle created for each namespace.
+	Schedules []ScheduleConfig `yaml:"schedules"`
+}
+
+// ScheduleConfig is one candidate Shutdown/WakeUp pair for a generated
+// SleepCycle.
+type ScheduleConfig struct {
+	Shutdown string `yaml:"shutdown"`
+	WakeUp   string `yaml:"wakeUp"`
+}
+
+// LoadGeneratorConfig reads and parses a GeneratorConfig from path.
+func LoadGeneratorConfig(path string) (*GeneratorConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var config GeneratorConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
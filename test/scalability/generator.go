@@ -0,0 +1,188 @@
+package scalability
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	corev1alpha1 "github.com/rekuberate-io/sleepcycles/api/v1alpha1"
+	"github.com/rekuberate-io/sleepcycles/controllers"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Generator applies a GeneratorConfig against an envtest cluster, creating
+// N namespaces each tagged with a SleepCycle and M workloads of every kind.
+type Generator struct {
+	Client client.Client
+	Config GeneratorConfig
+	Rand   *rand.Rand
+}
+
+// NewGenerator builds a Generator with a seeded random source, so runs are
+// reproducible given the same seed.
+func NewGenerator(c client.Client, config GeneratorConfig, seed int64) *Generator {
+	return &Generator{Client: c, Config: config, Rand: rand.New(rand.NewSource(seed))}
+}
+
+// Generate creates every namespace, SleepCycle and workload described by the
+// GeneratorConfig and returns the names of the namespaces it created.
+func (g *Generator) Generate(ctx context.Context) ([]string, error) {
+	namespaces := make([]string, 0, g.Config.Namespaces)
+
+	for i := 0; i < g.Config.Namespaces; i++ {
+		namespace := fmt.Sprintf("scale-test-%d", i)
+
+		if err := g.Client.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}); err != nil {
+			return nil, err
+		}
+
+		schedule := g.Config.Schedules[g.Rand.Intn(len(g.Config.Schedules))]
+		sleepCycleName := "scale-test"
+
+		sleepCycle := corev1alpha1.SleepCycle{
+			ObjectMeta: metav1.ObjectMeta{Name: sleepCycleName, Namespace: namespace},
+			Spec: corev1alpha1.SleepCycleSpec{
+				Enabled:  true,
+				Shutdown: schedule.Shutdown,
+				WakeUp:   schedule.WakeUp,
+			},
+		}
+		if err := g.Client.Create(ctx, &sleepCycle); err != nil {
+			return nil, err
+		}
+
+		if err := g.generateWorkloads(ctx, namespace, sleepCycleName); err != nil {
+			return nil, err
+		}
+
+		namespaces = append(namespaces, namespace)
+	}
+
+	return namespaces, nil
+}
+
+func (g *Generator) generateWorkloads(ctx context.Context, namespace string, sleepCycleName string) error {
+	labels := map[string]string{controllers.SleepCycleLabel: sleepCycleName}
+
+	for i := 0; i < g.Config.WorkloadsPerNamespace.Deployments; i++ {
+		replicas := g.randomReplicas()
+		name := fmt.Sprintf("deploy-%d", i)
+		podLabels := podLabelsFor(labels, name)
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: &replicas,
+				Selector: &metav1.LabelSelector{MatchLabels: podLabels},
+				Template: podTemplateFor(podLabels),
+			},
+		}
+		if err := g.Client.Create(ctx, deployment); err != nil {
+			return err
+		}
+	}
+
+	for i := 0; i < g.Config.WorkloadsPerNamespace.StatefulSets; i++ {
+		replicas := g.randomReplicas()
+		name := fmt.Sprintf("sts-%d", i)
+		podLabels := podLabelsFor(labels, name)
+		statefulSet := &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas:    &replicas,
+				ServiceName: name,
+				Selector:    &metav1.LabelSelector{MatchLabels: podLabels},
+				Template:    podTemplateFor(podLabels),
+			},
+		}
+		if err := g.Client.Create(ctx, statefulSet); err != nil {
+			return err
+		}
+	}
+
+	for i := 0; i < g.Config.WorkloadsPerNamespace.CronJobs; i++ {
+		suspend := false
+		name := fmt.Sprintf("cron-%d", i)
+		cronJob := &batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+			Spec: batchv1.CronJobSpec{
+				Schedule: "*/5 * * * *",
+				Suspend:  &suspend,
+				JobTemplate: batchv1.JobTemplateSpec{
+					Spec: batchv1.JobSpec{
+						Template: corev1.PodTemplateSpec{
+							ObjectMeta: metav1.ObjectMeta{Labels: podLabelsFor(labels, name)},
+							Spec: corev1.PodSpec{
+								RestartPolicy: corev1.RestartPolicyOnFailure,
+								Containers: []corev1.Container{
+									{Name: "workload", Image: "registry.k8s.io/pause:3.9"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		if err := g.Client.Create(ctx, cronJob); err != nil {
+			return err
+		}
+	}
+
+	for i := 0; i < g.Config.WorkloadsPerNamespace.HorizontalPodAutoscalers; i++ {
+		maxReplicas := g.randomReplicas()
+		hpa := &autoscalingv1.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("hpa-%d", i), Namespace: namespace, Labels: labels},
+			Spec: autoscalingv1.HorizontalPodAutoscalerSpec{
+				MaxReplicas: maxReplicas,
+				ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       fmt.Sprintf("hpa-target-%d", i),
+				},
+			},
+		}
+		if err := g.Client.Create(ctx, hpa); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// podLabelsFor builds the pod-template labels a generated workload's
+// Selector must match, scoped by name so sibling workloads in the same
+// namespace don't collide.
+func podLabelsFor(sleepCycleLabels map[string]string, name string) map[string]string {
+	podLabels := make(map[string]string, len(sleepCycleLabels)+1)
+	for k, v := range sleepCycleLabels {
+		podLabels[k] = v
+	}
+	podLabels["app"] = name
+	return podLabels
+}
+
+// podTemplateFor is the minimal PodTemplateSpec the apiserver requires to
+// accept a Deployment/StatefulSet: a container with an image.
+func podTemplateFor(podLabels map[string]string) corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: podLabels},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "workload", Image: "registry.k8s.io/pause:3.9"},
+			},
+		},
+	}
+}
+
+func (g *Generator) randomReplicas() int32 {
+	span := g.Config.MaxReplicas - g.Config.MinReplicas
+	if span <= 0 {
+		return int32(g.Config.MinReplicas)
+	}
+
+	return int32(g.Config.MinReplicas + g.Rand.Intn(span+1))
+}
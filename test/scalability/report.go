@@ -0,0 +1,130 @@
+package scalability
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Sample is one observed reconcile for a single resource kind.
+type Sample struct {
+	Kind       string        `json:"kind"`
+	Duration   time.Duration `json:"durationNanos"`
+	ScaledUp   bool          `json:"scaledUp"`
+	ScaledDown bool          `json:"scaledDown"`
+	APICalls   int           `json:"apiCalls"`
+}
+
+// KindReport aggregates every Sample observed for one resource kind.
+type KindReport struct {
+	Kind          string  `json:"kind"`
+	Count         int     `json:"count"`
+	P50Millis     float64 `json:"p50Millis"`
+	P95Millis     float64 `json:"p95Millis"`
+	P99Millis     float64 `json:"p99Millis"`
+	ScaleUps      int     `json:"scaleUps"`
+	ScaleDowns    int     `json:"scaleDowns"`
+	TotalAPICalls int     `json:"totalApiCalls"`
+}
+
+// Report is the final, per-kind summary emitted by the runner.
+type Report struct {
+	Kinds []KindReport `json:"kinds"`
+}
+
+// BuildReport groups samples by Kind and computes P50/P95/P99 reconcile
+// durations, plus scale-up/scale-down and API call totals, per kind.
+func BuildReport(samples []Sample) Report {
+	byKind := make(map[string][]Sample)
+	for _, s := range samples {
+		byKind[s.Kind] = append(byKind[s.Kind], s)
+	}
+
+	kinds := make([]string, 0, len(byKind))
+	for kind := range byKind {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	report := Report{Kinds: make([]KindReport, 0, len(kinds))}
+	for _, kind := range kinds {
+		group := byKind[kind]
+
+		durations := make([]time.Duration, len(group))
+		for i, s := range group {
+			durations[i] = s.Duration
+		}
+		sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+
+		kr := KindReport{
+			Kind:      kind,
+			Count:     len(group),
+			P50Millis: percentileMillis(durations, 0.50),
+			P95Millis: percentileMillis(durations, 0.95),
+			P99Millis: percentileMillis(durations, 0.99),
+		}
+
+		for _, s := range group {
+			if s.ScaledUp {
+				kr.ScaleUps++
+			}
+			if s.ScaledDown {
+				kr.ScaleDowns++
+			}
+			kr.TotalAPICalls += s.APICalls
+		}
+
+		report.Kinds = append(report.Kinds, kr)
+	}
+
+	return report
+}
+
+func percentileMillis(sorted []time.Duration, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	idx := int(p * float64(len(sorted)-1))
+	return float64(sorted[idx]) / float64(time.Millisecond)
+}
+
+// WriteJSON writes report to w as indented JSON.
+func (r Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteCSV writes report to w as CSV, one row per resource kind.
+func (r Report) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	defer writer.Flush()
+
+	header := []string{"kind", "count", "p50_ms", "p95_ms", "p99_ms", "scale_ups", "scale_downs", "api_calls"}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, kr := range r.Kinds {
+		row := []string{
+			kr.Kind,
+			strconv.Itoa(kr.Count),
+			fmt.Sprintf("%.2f", kr.P50Millis),
+			fmt.Sprintf("%.2f", kr.P95Millis),
+			fmt.Sprintf("%.2f", kr.P99Millis),
+			strconv.Itoa(kr.ScaleUps),
+			strconv.Itoa(kr.ScaleDowns),
+			strconv.Itoa(kr.TotalAPICalls),
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}